@@ -4,8 +4,10 @@ import (
 	"context"
 	"flag"
 	"net"
-	"net/http"
+	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 
 	_ "github.com/golang-migrate/migrate/v4/database/mongodb"
@@ -13,6 +15,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/roman-wb/price-service/internal/database"
+	"github.com/roman-wb/price-service/internal/jobs"
 	"github.com/roman-wb/price-service/internal/parser"
 	pb "github.com/roman-wb/price-service/internal/proto"
 	"github.com/roman-wb/price-service/internal/repos"
@@ -25,6 +28,17 @@ var addr = flag.String("addr", "localhost:50051", "Listen on host:port")
 var mode = flag.String("mode", "dev", "Run mode dev or prod")
 var mongo = flag.String("mongo", "mongodb://localhost:27017", "URL to MongoDB without db name")
 var dbName = flag.String("dbname", "price_service", "Database name")
+var workers = flag.Int("workers", 2, "Count of import workers")
+var batchSize = flag.Int("batch-size", repos.DefaultBatchSize, "Count of prices written to mongo at once")
+var schedulePoll = flag.Duration("schedule-poll", 10*time.Second, "Interval between checks of due schedules")
+var allowSchemes = flag.String("allow-schemes", "http,https", "Comma separated schemes of feed URLs")
+var allowHosts = flag.String("allow-hosts", "", "Comma separated domains, IPs or CIDRs of feed URLs, any host if empty")
+var denyHosts = flag.String("deny-hosts", "", "Comma separated domains, IPs or CIDRs denied for feed URLs")
+var maxBytes = flag.Int64("max-bytes", 100<<20, "Max size of feed in bytes, downloaded and unpacked, 0 is unlimited")
+var maxRows = flag.Int("max-rows", 1000000, "Max count of rows in feed, 0 is unlimited")
+var fetchTimeout = flag.Duration("fetch-timeout", 5*time.Minute, "Max time of feed download, 0 is unlimited")
+var allowContentTypes = flag.String("allow-content-types", "", "Comma separated media types of feed responses like text/csv or application/*, any if empty")
+var allowPrivate = flag.Bool("allow-private", false, "Allow feed URLs of loopback, private and link-local addresses")
 
 func main() {
 	flag.Parse()
@@ -58,9 +72,32 @@ func main() {
 	db := client.Database(*dbName)
 
 	// Deps
-	parser := parser.NewParser(&http.Client{})
-	priceRepo := repos.NewPriceRepo(db)
-	priceServer := servers.NewPriceServer(logger.Sugar(), parser, priceRepo)
+	policy := parser.URLPolicy{
+		Schemes:      splitList(*allowSchemes),
+		AllowHosts:   splitList(*allowHosts),
+		DenyHosts:    splitList(*denyHosts),
+		AllowPrivate: *allowPrivate,
+	}
+	limits := parser.Limits{
+		MaxBytes: *maxBytes,
+		MaxRows:  *maxRows,
+		Timeout:  *fetchTimeout,
+	}
+	parser := parser.NewParser(parser.NewHttpClient(policy), policy, limits, splitList(*allowContentTypes))
+	priceRepo := repos.NewPriceRepo(db, *batchSize)
+	jobRepo := repos.NewJobRepo(db)
+	rateRepo := repos.NewRateRepo(db)
+	scheduleRepo := repos.NewScheduleRepo(db)
+	feedStateRepo := repos.NewFeedStateRepo(db)
+	pool := jobs.NewPool(logger.Sugar(), parser, priceRepo, jobRepo, feedStateRepo, *workers, time.Second)
+	scheduler := jobs.NewScheduler(logger.Sugar(), pool, scheduleRepo, *schedulePoll)
+	priceServer := servers.NewPriceServer(logger.Sugar(), pool, priceRepo, jobRepo, rateRepo, scheduleRepo)
+
+	// Workers, scheduler is stopped first so it doesn't submit to stopped pool
+	pool.Start()
+	defer pool.Stop()
+	scheduler.Start()
+	defer scheduler.Stop()
 
 	// GRPC
 	grpcServer := grpc.NewServer()
@@ -73,7 +110,29 @@ func main() {
 	if err != nil {
 		logger.Sugar().Fatalf("failed to listen: %v", err)
 	}
+
+	// Graceful stop finishes calls, then workers are stopped by defers
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+		sig := <-signals
+		logger.Sugar().Infof("Service is stopping by %s", sig)
+		grpcServer.GracefulStop()
+	}()
+
 	if err := grpcServer.Serve(listen); err != nil {
 		logger.Sugar().Fatalf("failed to serve: %v", err)
 	}
+	logger.Sugar().Infof("Service stopped")
+}
+
+func splitList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
 }
@@ -0,0 +1,120 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/roman-wb/price-service/internal/jobs (interfaces: Submitter,ScheduleRepo)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/roman-wb/price-service/internal/models"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MockSubmitter is a mock of Submitter interface.
+type MockSubmitter struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubmitterMockRecorder
+}
+
+// MockSubmitterMockRecorder is the mock recorder for MockSubmitter.
+type MockSubmitterMockRecorder struct {
+	mock *MockSubmitter
+}
+
+// NewMockSubmitter creates a new mock instance.
+func NewMockSubmitter(ctrl *gomock.Controller) *MockSubmitter {
+	mock := &MockSubmitter{ctrl: ctrl}
+	mock.recorder = &MockSubmitterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubmitter) EXPECT() *MockSubmitterMockRecorder {
+	return m.recorder
+}
+
+// Submit mocks base method.
+func (m *MockSubmitter) Submit(arg0 context.Context, arg1 models.Feed, arg2 time.Time) (models.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Submit", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Submit indicates an expected call of Submit.
+func (mr *MockSubmitterMockRecorder) Submit(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockSubmitter)(nil).Submit), arg0, arg1, arg2)
+}
+
+// MockScheduleRepo is a mock of ScheduleRepo interface.
+type MockScheduleRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleRepoMockRecorder
+}
+
+// MockScheduleRepoMockRecorder is the mock recorder for MockScheduleRepo.
+type MockScheduleRepoMockRecorder struct {
+	mock *MockScheduleRepo
+}
+
+// NewMockScheduleRepo creates a new mock instance.
+func NewMockScheduleRepo(ctrl *gomock.Controller) *MockScheduleRepo {
+	mock := &MockScheduleRepo{ctrl: ctrl}
+	mock.recorder = &MockScheduleRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduleRepo) EXPECT() *MockScheduleRepoMockRecorder {
+	return m.recorder
+}
+
+// Advance mocks base method.
+func (m *MockScheduleRepo) Advance(arg0 context.Context, arg1 models.Schedule, arg2, arg3 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Advance", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Advance indicates an expected call of Advance.
+func (mr *MockScheduleRepoMockRecorder) Advance(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Advance", reflect.TypeOf((*MockScheduleRepo)(nil).Advance), arg0, arg1, arg2, arg3)
+}
+
+// Due mocks base method.
+func (m *MockScheduleRepo) Due(arg0 context.Context, arg1 time.Time, arg2 int) ([]models.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Due", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Due indicates an expected call of Due.
+func (mr *MockScheduleRepoMockRecorder) Due(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Due", reflect.TypeOf((*MockScheduleRepo)(nil).Due), arg0, arg1, arg2)
+}
+
+// SetLastJob mocks base method.
+func (m *MockScheduleRepo) SetLastJob(arg0 context.Context, arg1, arg2 primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLastJob", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLastJob indicates an expected call of SetLastJob.
+func (mr *MockScheduleRepoMockRecorder) SetLastJob(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLastJob", reflect.TypeOf((*MockScheduleRepo)(nil).SetLastJob), arg0, arg1, arg2)
+}
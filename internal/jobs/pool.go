@@ -0,0 +1,305 @@
+//go:generate mockgen -destination mocks/pool.go -package=mocks . Logger,Parser,PriceRepo,JobRepo,FeedStateRepo
+
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/roman-wb/price-service/internal/models"
+)
+
+// ProgressStep is count of read rows between progress updates.
+const ProgressStep = 1000
+
+// LeaseDuration is lock of running job, it's extended every third of it
+// while job runs. Job of crashed instance is claimed again after it.
+const LeaseDuration = time.Minute
+
+type Logger interface {
+	Infof(template string, args ...interface{})
+	Errorf(template string, args ...interface{})
+}
+
+type Parser interface {
+	Validate(feed models.Feed) error
+	Fetch(ctx context.Context, feed models.Feed, previous models.FeedState) (models.PriceReader, models.FeedState, error)
+}
+
+type PriceRepo interface {
+	Import(ctx context.Context, updatedAt time.Time, source string, reader models.PriceReader) (models.ImportStats, error)
+	DeleteMissing(ctx context.Context, updatedAt time.Time, source string, maxPercent int) (int, error)
+}
+
+type JobRepo interface {
+	Create(ctx context.Context, job models.Job) (models.Job, error)
+	Claim(ctx context.Context, startedAt time.Time, lockedUntil time.Time) (*models.Job, error)
+	Extend(ctx context.Context, job models.Job, lockedUntil time.Time) error
+	Progress(ctx context.Context, job models.Job) error
+	Finish(ctx context.Context, job models.Job) error
+}
+
+type FeedStateRepo interface {
+	Get(ctx context.Context, url string) (*models.FeedState, error)
+	Set(ctx context.Context, state models.FeedState) error
+}
+
+// Pool runs import jobs in background workers. Jobs are queued in
+// storage, so a job submitted to one service instance can be run by
+// any of them.
+type Pool struct {
+	logger    Logger
+	parser    Parser
+	priceRepo PriceRepo
+	jobRepo   JobRepo
+	stateRepo FeedStateRepo
+
+	size           int
+	pollInterval   time.Duration
+	extendInterval time.Duration
+
+	wakeup chan struct{}
+	done   chan struct{}
+	wg     sync.WaitGroup
+}
+
+func NewPool(logger Logger, parser Parser, priceRepo PriceRepo, jobRepo JobRepo, stateRepo FeedStateRepo, size int, pollInterval time.Duration) *Pool {
+	return &Pool{
+		logger:         logger,
+		parser:         parser,
+		priceRepo:      priceRepo,
+		jobRepo:        jobRepo,
+		stateRepo:      stateRepo,
+		size:           size,
+		pollInterval:   pollInterval,
+		extendInterval: LeaseDuration / 3,
+		wakeup:         make(chan struct{}, size),
+		done:           make(chan struct{}),
+	}
+}
+
+// Validate checks feed without fetching it.
+func (p *Pool) Validate(feed models.Feed) error {
+	return p.parser.Validate(feed)
+}
+
+// Submit validates feed and queues a new job. Job outlives ctx, it fails
+// after deadline unless deadline is zero.
+func (p *Pool) Submit(ctx context.Context, feed models.Feed, deadline time.Time) (models.Job, error) {
+	err := p.Validate(feed)
+	if err != nil {
+		return models.Job{}, err
+	}
+
+	job, err := p.jobRepo.Create(ctx, models.Job{
+		Feed:      feed,
+		State:     models.JobQueued,
+		Deadline:  deadline,
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return job, err
+	}
+
+	select {
+	case p.wakeup <- struct{}{}:
+	default:
+	}
+
+	return job, nil
+}
+
+func (p *Pool) Start() {
+	for i := 0; i < p.size; i++ {
+		p.wg.Add(1)
+		go p.work()
+	}
+}
+
+// Stop waits for running jobs to finish.
+func (p *Pool) Stop() {
+	close(p.done)
+	p.wg.Wait()
+}
+
+func (p *Pool) work() {
+	defer p.wg.Done()
+
+	for {
+		select {
+		case <-p.done:
+			return
+		default:
+		}
+
+		now := time.Now().UTC()
+		job, err := p.jobRepo.Claim(context.Background(), now, now.Add(LeaseDuration))
+		if err != nil {
+			p.logger.Errorf("failed claim job: %v", err)
+		}
+		if job != nil {
+			p.run(*job)
+			continue
+		}
+
+		select {
+		case <-p.done:
+			return
+		case <-p.wakeup:
+		case <-time.After(p.pollInterval):
+		}
+	}
+}
+
+func (p *Pool) run(job models.Job) {
+	p.logger.Infof("Job %s started: %s", job.ID.Hex(), job.Feed.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if !job.Deadline.IsZero() {
+		var cancelDeadline context.CancelFunc
+		ctx, cancelDeadline = context.WithDeadline(ctx, job.Deadline)
+		defer cancelDeadline()
+	}
+
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		p.extendLease(job, stop, cancel)
+	}()
+
+	err := p.importJob(ctx, &job)
+	close(stop)
+	<-stopped
+
+	job.FinishedAt = time.Now().UTC()
+	job.State = models.JobDone
+	if err != nil {
+		job.State = models.JobFailed
+		job.Error = err.Error()
+		job.ErrorCode = models.ErrorCode(err).String()
+	}
+
+	// Job is finished even after its deadline
+	err = p.jobRepo.Finish(context.Background(), job)
+	if err != nil {
+		p.logger.Errorf("failed finish job %s: %v", job.ID.Hex(), err)
+		return
+	}
+
+	p.logger.Infof("Job %s %s", job.ID.Hex(), job.State)
+}
+
+// extendLease extends lock of running job until stop is closed. Import
+// is canceled when job is claimed by another worker.
+func (p *Pool) extendLease(job models.Job, stop <-chan struct{}, cancel context.CancelFunc) {
+	ticker := time.NewTicker(p.extendInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			err := p.jobRepo.Extend(context.Background(), job, time.Now().UTC().Add(LeaseDuration))
+			if err == models.ErrJobLost {
+				p.logger.Errorf("job %s is claimed by another worker", job.ID.Hex())
+				cancel()
+				return
+			}
+			if err != nil {
+				p.logger.Errorf("failed extend job %s: %v", job.ID.Hex(), err)
+			}
+		}
+	}
+}
+
+// importJob skips feed unchanged since the last import with the same
+// options.
+func (p *Pool) importJob(ctx context.Context, job *models.Job) error {
+	previous, err := p.stateRepo.Get(ctx, job.Feed.URL)
+	if err != nil {
+		return err
+	}
+	if previous == nil || !previous.Matches(job.Feed) {
+		previous = &models.FeedState{}
+	}
+
+	reader, state, err := p.parser.Fetch(ctx, job.Feed, *previous)
+	if err == models.ErrNotModified {
+		job.Unchanged = true
+		// Feed of the same hash is returned with fresh ETag and
+		// Last-Modified, they're saved to be sent next time
+		if state.Hash == "" {
+			return nil
+		}
+		return p.saveState(ctx, job.Feed, state)
+	}
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	progress := &progressReader{
+		PriceReader: reader,
+		ctx:         ctx,
+		job:         job,
+		jobRepo:     p.jobRepo,
+	}
+
+	updatedAt := time.Now().UTC()
+	stats, err := p.priceRepo.Import(ctx, updatedAt, job.Feed.Source, progress)
+	readStats := reader.Stats()
+	job.Processed = readStats.Parsed + readStats.Rejected
+	if err != nil {
+		return err
+	}
+
+	stats.Parsed = readStats.Parsed
+	stats.Rejected = readStats.Rejected
+	stats.Rejects = readStats.Rejects
+	job.Stats = stats
+
+	if job.Feed.Snapshot {
+		job.Stats.Deleted, err = p.priceRepo.DeleteMissing(ctx, updatedAt, job.Feed.Source, job.Feed.MaxDeleted())
+		if err != nil {
+			return err
+		}
+	}
+
+	return p.saveState(ctx, job.Feed, state)
+}
+
+func (p *Pool) saveState(ctx context.Context, feed models.Feed, state models.FeedState) error {
+	state.URL = feed.URL
+	state.Feed = feed
+	state.UpdatedAt = time.Now().UTC()
+	return p.stateRepo.Set(ctx, state)
+}
+
+// progressReader saves count of processed rows every ProgressStep rows.
+type progressReader struct {
+	models.PriceReader
+
+	ctx     context.Context
+	job     *models.Job
+	jobRepo JobRepo
+}
+
+func (r *progressReader) Read() (models.Price, error) {
+	price, err := r.PriceReader.Read()
+	if err != nil {
+		return price, err
+	}
+
+	stats := r.PriceReader.Stats()
+	processed := stats.Parsed + stats.Rejected
+	if processed-r.job.Processed >= ProgressStep {
+		r.job.Processed = processed
+		err = r.jobRepo.Progress(r.ctx, *r.job)
+	}
+
+	return price, err
+}
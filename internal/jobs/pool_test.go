@@ -0,0 +1,594 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/roman-wb/price-service/internal/jobs/mocks"
+	"github.com/roman-wb/price-service/internal/models"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestNewPool(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	wantMockLogger := mocks.NewMockLogger(ctrl)
+	wantMockParser := mocks.NewMockParser(ctrl)
+	wantMockPriceRepo := mocks.NewMockPriceRepo(ctrl)
+	wantMockJobRepo := mocks.NewMockJobRepo(ctrl)
+	wantMockFeedStateRepo := mocks.NewMockFeedStateRepo(ctrl)
+
+	gotPool := NewPool(wantMockLogger, wantMockParser, wantMockPriceRepo, wantMockJobRepo, wantMockFeedStateRepo, 2, time.Second)
+
+	require.NotNil(t, gotPool)
+	require.Equal(t, wantMockLogger, gotPool.logger)
+	require.Equal(t, wantMockParser, gotPool.parser)
+	require.Equal(t, wantMockPriceRepo, gotPool.priceRepo)
+	require.Equal(t, wantMockJobRepo, gotPool.jobRepo)
+	require.Equal(t, wantMockFeedStateRepo, gotPool.stateRepo)
+	require.Equal(t, 2, gotPool.size)
+	require.Equal(t, time.Second, gotPool.pollInterval)
+}
+
+func TestPoolSubmit(t *testing.T) {
+	id := primitive.NewObjectID()
+	deadline := time.Now().Add(time.Minute).UTC()
+
+	testCases := []struct {
+		name string
+
+		feed           models.Feed
+		isMockJobRepo  bool
+		mockParserErr  error
+		mockJobRepoJob models.Job
+		mockJobRepoErr error
+		wantWakeup     bool
+
+		wantJob models.Job
+		wantErr error
+	}{
+		{
+			name: "Parser returns error",
+
+			feed:          models.Feed{},
+			mockParserErr: errors.New(`parse "": empty url`),
+
+			wantJob: models.Job{},
+			wantErr: errors.New(`parse "": empty url`),
+		},
+		{
+			name: "Repo returns error",
+
+			feed:           models.Feed{URL: "http://yandex.ru"},
+			isMockJobRepo:  true,
+			mockJobRepoErr: errors.New(`some error...`),
+
+			wantJob: models.Job{},
+			wantErr: errors.New(`some error...`),
+		},
+		{
+			name: "Job queued",
+
+			feed:           models.Feed{URL: "http://yandex.ru"},
+			isMockJobRepo:  true,
+			mockJobRepoJob: models.Job{ID: id, Feed: models.Feed{URL: "http://yandex.ru"}, State: models.JobQueued},
+			wantWakeup:     true,
+
+			wantJob: models.Job{ID: id, Feed: models.Feed{URL: "http://yandex.ru"}, State: models.JobQueued},
+			wantErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockParser := mocks.NewMockParser(ctrl)
+			mockParser.
+				EXPECT().
+				Validate(tc.feed).
+				Return(tc.mockParserErr)
+
+			mockJobRepo := mocks.NewMockJobRepo(ctrl)
+			if tc.isMockJobRepo {
+				mockJobRepo.
+					EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, job models.Job) (models.Job, error) {
+						require.Equal(t, tc.feed, job.Feed)
+						require.Equal(t, models.JobQueued, job.State)
+						require.Equal(t, deadline, job.Deadline)
+						require.False(t, job.CreatedAt.IsZero())
+						return tc.mockJobRepoJob, tc.mockJobRepoErr
+					})
+			}
+
+			pool := NewPool(nil, mockParser, nil, mockJobRepo, nil, 1, time.Second)
+
+			gotJob, gotErr := pool.Submit(context.Background(), tc.feed, deadline)
+
+			require.Equal(t, tc.wantJob, gotJob)
+			require.Equal(t, tc.wantErr, gotErr)
+			require.Equal(t, tc.wantWakeup, len(pool.wakeup) == 1)
+		})
+	}
+}
+
+type sliceReader struct {
+	prices []models.Price
+	stats  models.ImportStats
+	closed bool
+}
+
+func (r *sliceReader) Read() (models.Price, error) {
+	if r.stats.Parsed == len(r.prices) {
+		return models.Price{}, io.EOF
+	}
+	r.stats.Parsed++
+	return r.prices[r.stats.Parsed-1], nil
+}
+
+func (r *sliceReader) Stats() models.ImportStats {
+	return r.stats
+}
+
+func (r *sliceReader) Close() error {
+	r.closed = true
+	return nil
+}
+
+func readAll(reader models.PriceReader) error {
+	for {
+		_, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func TestPoolRun(t *testing.T) {
+	id := primitive.NewObjectID()
+	lockID := primitive.NewObjectID()
+	feed := models.Feed{URL: "http://yandex.ru", Source: "shop1"}
+	deadline := time.Now().Add(time.Minute).UTC()
+
+	testCases := []struct {
+		name string
+
+		deadline         time.Time
+		snapshot         bool
+		mockPrevious     *models.FeedState
+		wantPrevious     models.FeedState
+		isMockPriceRepo  bool
+		mockParserReader *sliceReader
+		mockParserState  models.FeedState
+		mockParserErr    error
+		wantState        bool
+		mockImportStats  models.ImportStats
+		mockImportErr    error
+		isMockDelete     bool
+		mockDeleted      int
+		mockDeleteErr    error
+		wantProgress     []int
+
+		wantJob models.Job
+	}{
+		{
+			name: "Parser returns error",
+
+			mockParserErr: errors.New(`http error...`),
+
+			wantJob: models.Job{ID: id, State: models.JobFailed, Error: "http error...", ErrorCode: "Unknown"},
+		},
+		{
+			name: "Feed is over limits",
+
+			mockParserErr: fmt.Errorf("%w: feed is larger than 10 bytes", models.ErrLimitExceeded),
+
+			wantJob: models.Job{
+				ID:        id,
+				State:     models.JobFailed,
+				Error:     "limit exceeded: feed is larger than 10 bytes",
+				ErrorCode: "ResourceExhausted",
+			},
+		},
+		{
+			name: "Download is over deadline",
+
+			deadline:      deadline,
+			mockParserErr: fmt.Errorf("Get \"http://yandex.ru\": %w", context.DeadlineExceeded),
+
+			wantJob: models.Job{
+				ID:        id,
+				State:     models.JobFailed,
+				Error:     `Get "http://yandex.ru": context deadline exceeded`,
+				ErrorCode: "DeadlineExceeded",
+				Deadline:  deadline,
+			},
+		},
+		{
+			name: "Feed not modified",
+
+			mockPrevious:  &models.FeedState{URL: "http://yandex.ru", Feed: feed, ETag: `"v1"`, Hash: "hash"},
+			wantPrevious:  models.FeedState{URL: "http://yandex.ru", Feed: feed, ETag: `"v1"`, Hash: "hash"},
+			mockParserErr: models.ErrNotModified,
+
+			wantJob: models.Job{ID: id, State: models.JobDone, Unchanged: true},
+		},
+		{
+			name: "Feed of the same hash saves fresh state",
+
+			mockPrevious:    &models.FeedState{URL: "http://yandex.ru", Feed: feed, ETag: `"v1"`, Hash: "hash"},
+			wantPrevious:    models.FeedState{URL: "http://yandex.ru", Feed: feed, ETag: `"v1"`, Hash: "hash"},
+			mockParserState: models.FeedState{ETag: `"v2"`, LastModified: "Wed, 21 Oct 2015 07:28:00 GMT", Hash: "hash"},
+			mockParserErr:   models.ErrNotModified,
+			wantState:       true,
+
+			wantJob: models.Job{ID: id, State: models.JobDone, Unchanged: true},
+		},
+		{
+			name: "Previous state of other options is ignored",
+
+			isMockPriceRepo: true,
+			mockPrevious:    &models.FeedState{URL: "http://yandex.ru", Feed: models.Feed{URL: "http://yandex.ru"}, ETag: `"v1"`},
+			wantPrevious:    models.FeedState{},
+			mockParserReader: &sliceReader{
+				prices: []models.Price{{Name: "Product 1", Price: models.MustParsePrice("0")}},
+			},
+			mockParserState: models.FeedState{ETag: `"v2"`, Hash: "hash"},
+			mockImportStats: models.ImportStats{Inserted: 1},
+			wantState:       true,
+
+			wantJob: models.Job{
+				ID:        id,
+				State:     models.JobDone,
+				Processed: 1,
+				Stats:     models.ImportStats{Parsed: 1, Inserted: 1},
+			},
+		},
+		{
+			name: "Repo returns error",
+
+			isMockPriceRepo: true,
+			mockParserReader: &sliceReader{
+				prices: []models.Price{{Name: "Product 1", Price: models.MustParsePrice("0")}},
+			},
+			mockImportErr: errors.New(`some error...`),
+
+			wantJob: models.Job{ID: id, State: models.JobFailed, Processed: 1, Error: "some error...", ErrorCode: "Unknown"},
+		},
+		{
+			name: "Job done",
+
+			isMockPriceRepo: true,
+			mockParserReader: &sliceReader{
+				prices: []models.Price{
+					{Name: "Product 1", Price: models.MustParsePrice("0")},
+					{Name: "Product 2", Price: models.MustParsePrice("100.99")},
+				},
+				stats: models.ImportStats{
+					Rejected: 1,
+					Rejects: []models.Reject{
+						{Line: 3, Reason: "wrong number of fields"},
+					},
+				},
+			},
+			mockParserState: models.FeedState{ETag: `"v2"`, Hash: "hash"},
+			mockImportStats: models.ImportStats{Inserted: 1, Unchanged: 1},
+			wantState:       true,
+
+			wantJob: models.Job{
+				ID:        id,
+				State:     models.JobDone,
+				Processed: 3,
+				Stats: models.ImportStats{
+					Parsed:    2,
+					Rejected:  1,
+					Inserted:  1,
+					Unchanged: 1,
+					Rejects: []models.Reject{
+						{Line: 3, Reason: "wrong number of fields"},
+					},
+				},
+			},
+		},
+		{
+			name: "Snapshot deletes missing prices",
+
+			snapshot:        true,
+			isMockPriceRepo: true,
+			mockParserReader: &sliceReader{
+				prices: []models.Price{{Name: "Product 1", Price: models.MustParsePrice("1")}},
+			},
+			mockImportStats: models.ImportStats{Unchanged: 1},
+			isMockDelete:    true,
+			mockDeleted:     2,
+			wantState:       true,
+
+			wantJob: models.Job{
+				ID:        id,
+				State:     models.JobDone,
+				Processed: 1,
+				Stats:     models.ImportStats{Parsed: 1, Unchanged: 1, Deleted: 2},
+			},
+		},
+		{
+			name: "Snapshot deletes too many prices",
+
+			snapshot:        true,
+			isMockPriceRepo: true,
+			mockParserReader: &sliceReader{
+				prices: []models.Price{{Name: "Product 1", Price: models.MustParsePrice("1")}},
+			},
+			mockImportStats: models.ImportStats{Unchanged: 1},
+			isMockDelete:    true,
+			mockDeleteErr:   fmt.Errorf(`%w: 3 of 4 prices of source "shop1" are missing in feed`, models.ErrTooManyDeleted),
+
+			wantJob: models.Job{
+				ID:        id,
+				State:     models.JobFailed,
+				Processed: 1,
+				Stats:     models.ImportStats{Parsed: 1, Unchanged: 1},
+				Error:     `too many prices deleted: 3 of 4 prices of source "shop1" are missing in feed`,
+				ErrorCode: "FailedPrecondition",
+			},
+		},
+		{
+			name: "Job saves progress",
+
+			isMockPriceRepo: true,
+			mockParserReader: &sliceReader{
+				prices: make([]models.Price, 2*ProgressStep+1),
+			},
+			mockImportStats: models.ImportStats{Inserted: 2*ProgressStep + 1},
+			wantProgress:    []int{ProgressStep, 2 * ProgressStep},
+			wantState:       true,
+
+			wantJob: models.Job{
+				ID:        id,
+				State:     models.JobDone,
+				Processed: 2*ProgressStep + 1,
+				Stats: models.ImportStats{
+					Parsed:   2*ProgressStep + 1,
+					Inserted: 2*ProgressStep + 1,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			feed := feed
+			feed.Snapshot = tc.snapshot
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+			mockFeedStateRepo := mocks.NewMockFeedStateRepo(ctrl)
+			mockFeedStateRepo.
+				EXPECT().
+				Get(gomock.Any(), "http://yandex.ru").
+				Return(tc.mockPrevious, nil)
+			if tc.wantState {
+				mockFeedStateRepo.
+					EXPECT().
+					Set(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, state models.FeedState) error {
+						require.False(t, state.UpdatedAt.IsZero())
+						state.UpdatedAt = time.Time{}
+						want := tc.mockParserState
+						want.URL = "http://yandex.ru"
+						want.Feed = feed
+						require.Equal(t, want, state)
+						return nil
+					})
+			}
+
+			mockParser := mocks.NewMockParser(ctrl)
+			mockParser.
+				EXPECT().
+				Fetch(gomock.Any(), feed, tc.wantPrevious).
+				DoAndReturn(func(ctx context.Context, feed models.Feed, previous models.FeedState) (models.PriceReader, models.FeedState, error) {
+					gotDeadline, _ := ctx.Deadline()
+					require.Equal(t, tc.deadline, gotDeadline)
+					if tc.mockParserReader == nil {
+						return nil, tc.mockParserState, tc.mockParserErr
+					}
+					return tc.mockParserReader, tc.mockParserState, nil
+				})
+
+			mockPriceRepo := mocks.NewMockPriceRepo(ctrl)
+			if tc.isMockPriceRepo {
+				mockPriceRepo.
+					EXPECT().
+					Import(gomock.Any(), gomock.Any(), "shop1", gomock.Any()).
+					DoAndReturn(func(ctx context.Context, updatedAt time.Time, source string, reader models.PriceReader) (models.ImportStats, error) {
+						gotDeadline, _ := ctx.Deadline()
+						require.Equal(t, tc.deadline, gotDeadline)
+						err := readAll(reader)
+						require.Nil(t, err)
+						return tc.mockImportStats, tc.mockImportErr
+					})
+			}
+			if tc.isMockDelete {
+				mockPriceRepo.
+					EXPECT().
+					DeleteMissing(gomock.Any(), gomock.Any(), "shop1", models.DefaultMaxDeletedPercent).
+					Return(tc.mockDeleted, tc.mockDeleteErr)
+			}
+
+			var progress []int
+			mockJobRepo := mocks.NewMockJobRepo(ctrl)
+			mockJobRepo.
+				EXPECT().
+				Progress(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, job models.Job) error {
+					require.Equal(t, id, job.ID)
+					require.Equal(t, lockID, job.LockID)
+					progress = append(progress, job.Processed)
+					return nil
+				}).
+				AnyTimes()
+			mockJobRepo.
+				EXPECT().
+				Finish(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, job models.Job) error {
+					require.False(t, job.FinishedAt.IsZero())
+					require.Equal(t, feed, job.Feed)
+					require.Equal(t, lockID, job.LockID)
+					job.Feed = models.Feed{}
+					job.FinishedAt = time.Time{}
+					job.LockID = primitive.ObjectID{}
+					require.Equal(t, tc.wantJob, job)
+					return nil
+				})
+
+			pool := NewPool(mockLogger, mockParser, mockPriceRepo, mockJobRepo, mockFeedStateRepo, 1, time.Second)
+
+			pool.run(models.Job{ID: id, LockID: lockID, Feed: feed, State: models.JobRunning, Deadline: tc.deadline})
+
+			require.Equal(t, tc.wantProgress, progress)
+
+			if tc.mockParserReader != nil {
+				require.True(t, tc.mockParserReader.closed)
+			}
+		})
+	}
+}
+
+func TestPoolStartStop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := primitive.NewObjectID()
+	finished := make(chan struct{})
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	mockParser := mocks.NewMockParser(ctrl)
+	mockParser.
+		EXPECT().
+		Fetch(gomock.Any(), models.Feed{URL: "http://yandex.ru"}, models.FeedState{}).
+		Return(&sliceReader{}, models.FeedState{}, nil)
+	mockFeedStateRepo := mocks.NewMockFeedStateRepo(ctrl)
+	mockFeedStateRepo.
+		EXPECT().
+		Get(gomock.Any(), "http://yandex.ru").
+		Return(nil, nil)
+	mockFeedStateRepo.
+		EXPECT().
+		Set(gomock.Any(), gomock.Any()).
+		Return(nil)
+	mockPriceRepo := mocks.NewMockPriceRepo(ctrl)
+	mockPriceRepo.
+		EXPECT().
+		Import(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(models.ImportStats{}, nil)
+	mockJobRepo := mocks.NewMockJobRepo(ctrl)
+	gomock.InOrder(
+		mockJobRepo.
+			EXPECT().
+			Claim(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, startedAt time.Time, lockedUntil time.Time) (*models.Job, error) {
+				require.Equal(t, startedAt.Add(LeaseDuration), lockedUntil)
+				return &models.Job{ID: id, Feed: models.Feed{URL: "http://yandex.ru"}, State: models.JobRunning}, nil
+			}),
+		mockJobRepo.
+			EXPECT().
+			Claim(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, nil).
+			AnyTimes(),
+	)
+	mockJobRepo.
+		EXPECT().
+		Finish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, job models.Job) error {
+			require.Equal(t, models.JobDone, job.State)
+			close(finished)
+			return nil
+		})
+
+	pool := NewPool(mockLogger, mockParser, mockPriceRepo, mockJobRepo, mockFeedStateRepo, 1, time.Millisecond)
+	pool.Start()
+
+	select {
+	case <-finished:
+	case <-time.After(time.Second):
+		t.Fatal("job wasn't finished")
+	}
+
+	pool.Stop()
+}
+
+func TestPoolRunJobLost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	job := models.Job{ID: primitive.NewObjectID(), LockID: primitive.NewObjectID(), Feed: models.Feed{URL: "http://yandex.ru"}, State: models.JobRunning}
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Errorf("job %s is claimed by another worker", job.ID.Hex())
+	mockLogger.EXPECT().Errorf("failed finish job %s: %v", job.ID.Hex(), models.ErrJobLost)
+	mockParser := mocks.NewMockParser(ctrl)
+	mockParser.
+		EXPECT().
+		Fetch(gomock.Any(), job.Feed, models.FeedState{}).
+		Return(&sliceReader{}, models.FeedState{}, nil)
+	mockFeedStateRepo := mocks.NewMockFeedStateRepo(ctrl)
+	mockFeedStateRepo.
+		EXPECT().
+		Get(gomock.Any(), "http://yandex.ru").
+		Return(nil, nil)
+	mockPriceRepo := mocks.NewMockPriceRepo(ctrl)
+	mockPriceRepo.
+		EXPECT().
+		Import(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, updatedAt time.Time, source string, reader models.PriceReader) (models.ImportStats, error) {
+			select {
+			case <-ctx.Done():
+				return models.ImportStats{}, ctx.Err()
+			case <-time.After(time.Second):
+				t.Fatal("import wasn't canceled")
+				return models.ImportStats{}, nil
+			}
+		})
+	mockJobRepo := mocks.NewMockJobRepo(ctrl)
+	mockJobRepo.
+		EXPECT().
+		Extend(gomock.Any(), job, gomock.Any()).
+		Return(models.ErrJobLost)
+	mockJobRepo.
+		EXPECT().
+		Finish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, got models.Job) error {
+			require.Equal(t, job.LockID, got.LockID)
+			require.Equal(t, models.JobFailed, got.State)
+			require.Equal(t, context.Canceled.Error(), got.Error)
+			return models.ErrJobLost
+		})
+
+	pool := NewPool(mockLogger, mockParser, mockPriceRepo, mockJobRepo, mockFeedStateRepo, 1, time.Second)
+	pool.extendInterval = time.Millisecond
+
+	pool.run(job)
+}
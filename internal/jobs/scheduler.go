@@ -0,0 +1,108 @@
+//go:generate mockgen -destination mocks/scheduler.go -package=mocks . Submitter,ScheduleRepo
+
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/roman-wb/price-service/internal/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// DueLimit is count of due schedules read by one tick.
+const DueLimit = 100
+
+type Submitter interface {
+	Submit(ctx context.Context, feed models.Feed, deadline time.Time) (models.Job, error)
+}
+
+type ScheduleRepo interface {
+	Due(ctx context.Context, now time.Time, limit int) ([]models.Schedule, error)
+	Advance(ctx context.Context, schedule models.Schedule, ranAt time.Time, nextRunAt time.Time) (bool, error)
+	SetLastJob(ctx context.Context, id primitive.ObjectID, jobID primitive.ObjectID) error
+}
+
+// Scheduler submits jobs of due schedules. Every service instance runs
+// a scheduler, a run is fired by the instance which advanced schedule in
+// storage first.
+type Scheduler struct {
+	logger       Logger
+	submitter    Submitter
+	scheduleRepo ScheduleRepo
+
+	pollInterval time.Duration
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewScheduler(logger Logger, submitter Submitter, scheduleRepo ScheduleRepo, pollInterval time.Duration) *Scheduler {
+	return &Scheduler{
+		logger:       logger,
+		submitter:    submitter,
+		scheduleRepo: scheduleRepo,
+		pollInterval: pollInterval,
+		done:         make(chan struct{}),
+	}
+}
+
+func (s *Scheduler) Start() {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		for {
+			// Tick isn't cancelled by Stop, so an advanced schedule
+			// always gets its job
+			s.tick(context.Background(), time.Now().UTC())
+
+			select {
+			case <-s.done:
+				return
+			case <-time.After(s.pollInterval):
+			}
+		}
+	}()
+}
+
+func (s *Scheduler) Stop() {
+	close(s.done)
+	s.wg.Wait()
+}
+
+// tick fires due schedules once, runs missed while service was down are
+// skipped.
+func (s *Scheduler) tick(ctx context.Context, now time.Time) {
+	schedules, err := s.scheduleRepo.Due(ctx, now, DueLimit)
+	if err != nil {
+		s.logger.Errorf("failed get due schedules: %v", err)
+		return
+	}
+
+	for _, schedule := range schedules {
+		advanced, err := s.scheduleRepo.Advance(ctx, schedule, now, schedule.Next(now))
+		if err != nil {
+			s.logger.Errorf("failed advance schedule %s: %v", schedule.ID.Hex(), err)
+			continue
+		}
+		if !advanced {
+			continue
+		}
+
+		job, err := s.submitter.Submit(ctx, schedule.Feed, time.Time{})
+		if err != nil {
+			s.logger.Errorf("failed submit schedule %s: %v", schedule.ID.Hex(), err)
+			continue
+		}
+
+		err = s.scheduleRepo.SetLastJob(ctx, schedule.ID, job.ID)
+		if err != nil {
+			s.logger.Errorf("failed save job of schedule %s: %v", schedule.ID.Hex(), err)
+			continue
+		}
+
+		s.logger.Infof("Schedule %s submitted job %s", schedule.ID.Hex(), job.ID.Hex())
+	}
+}
@@ -0,0 +1,160 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/roman-wb/price-service/internal/jobs/mocks"
+	"github.com/roman-wb/price-service/internal/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestSchedulerTick(t *testing.T) {
+	now := time.Date(2021, 7, 28, 10, 30, 0, 0, time.UTC)
+	id := primitive.NewObjectID()
+	jobID := primitive.NewObjectID()
+	schedule := models.Schedule{ID: id, Feed: models.Feed{URL: "http://yandex.ru"}, Interval: time.Hour, NextRunAt: now}
+
+	testCases := []struct {
+		name string
+
+		mockDueSchedules []models.Schedule
+		mockDueErr       error
+		mockAdvanced     bool
+		mockAdvanceErr   error
+		isMockSubmit     bool
+		mockSubmitErr    error
+		isMockSetLastJob bool
+
+		wantErrorf bool
+	}{
+		{
+			name: "Repo returns error",
+
+			mockDueErr: errors.New("some error..."),
+
+			wantErrorf: true,
+		},
+		{
+			name: "No due schedules",
+
+			mockDueSchedules: nil,
+		},
+		{
+			name: "Schedule advanced by another instance",
+
+			mockDueSchedules: []models.Schedule{schedule},
+			mockAdvanced:     false,
+		},
+		{
+			name: "Advance returns error",
+
+			mockDueSchedules: []models.Schedule{schedule},
+			mockAdvanceErr:   errors.New("some error..."),
+
+			wantErrorf: true,
+		},
+		{
+			name: "Submit returns error",
+
+			mockDueSchedules: []models.Schedule{schedule},
+			mockAdvanced:     true,
+			isMockSubmit:     true,
+			mockSubmitErr:    errors.New("invalid feed"),
+
+			wantErrorf: true,
+		},
+		{
+			name: "Schedule submitted",
+
+			mockDueSchedules: []models.Schedule{schedule},
+			mockAdvanced:     true,
+			isMockSubmit:     true,
+			isMockSetLastJob: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+			if tc.wantErrorf {
+				mockLogger.EXPECT().Errorf(gomock.Any(), gomock.Any())
+			}
+
+			mockScheduleRepo := mocks.NewMockScheduleRepo(ctrl)
+			mockScheduleRepo.
+				EXPECT().
+				Due(gomock.Any(), now, DueLimit).
+				Return(tc.mockDueSchedules, tc.mockDueErr)
+			for _, due := range tc.mockDueSchedules {
+				mockScheduleRepo.
+					EXPECT().
+					Advance(gomock.Any(), due, now, now.Add(time.Hour)).
+					Return(tc.mockAdvanced, tc.mockAdvanceErr)
+			}
+			if tc.isMockSetLastJob {
+				mockScheduleRepo.
+					EXPECT().
+					SetLastJob(gomock.Any(), id, jobID).
+					Return(nil)
+			}
+
+			mockSubmitter := mocks.NewMockSubmitter(ctrl)
+			if tc.isMockSubmit {
+				mockSubmitter.
+					EXPECT().
+					Submit(gomock.Any(), models.Feed{URL: "http://yandex.ru"}, time.Time{}).
+					Return(models.Job{ID: jobID}, tc.mockSubmitErr)
+			}
+
+			scheduler := NewScheduler(mockLogger, mockSubmitter, mockScheduleRepo, time.Second)
+
+			scheduler.tick(context.Background(), now)
+		})
+	}
+}
+
+func TestSchedulerStartStop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ticked := make(chan struct{})
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockScheduleRepo := mocks.NewMockScheduleRepo(ctrl)
+	gomock.InOrder(
+		mockScheduleRepo.
+			EXPECT().
+			Due(gomock.Any(), gomock.Any(), DueLimit).
+			DoAndReturn(func(ctx context.Context, now time.Time, limit int) ([]models.Schedule, error) {
+				close(ticked)
+				return nil, nil
+			}),
+		mockScheduleRepo.
+			EXPECT().
+			Due(gomock.Any(), gomock.Any(), DueLimit).
+			Return(nil, nil).
+			AnyTimes(),
+	)
+
+	scheduler := NewScheduler(mockLogger, nil, mockScheduleRepo, time.Millisecond)
+	scheduler.Start()
+
+	select {
+	case <-ticked:
+	case <-time.After(time.Second):
+		t.Fatal("scheduler didn't tick")
+	}
+
+	scheduler.Stop()
+}
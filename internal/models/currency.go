@@ -0,0 +1,26 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseCurrency checks ISO 4217 code like "usd" and returns it in upper
+// case. Empty currency means price without currency.
+func ParseCurrency(s string) (string, error) {
+	currency := strings.ToUpper(strings.TrimSpace(s))
+	if currency == "" {
+		return "", nil
+	}
+
+	if len(currency) != 3 {
+		return "", fmt.Errorf("invalid currency %q", s)
+	}
+	for _, r := range currency {
+		if r < 'A' || r > 'Z' {
+			return "", fmt.Errorf("invalid currency %q", s)
+		}
+	}
+
+	return currency, nil
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCurrency(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		s string
+
+		want    string
+		wantErr error
+	}{
+		{name: "Empty", s: "", want: ""},
+		{name: "Upper case", s: " eur ", want: "EUR"},
+		{name: "Symbol", s: "€", wantErr: errors.New(`invalid currency "€"`)},
+		{name: "Digits", s: "978", wantErr: errors.New(`invalid currency "978"`)},
+		{name: "Long", s: "EURO", wantErr: errors.New(`invalid currency "EURO"`)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotErr := ParseCurrency(tc.s)
+
+			require.Equal(t, tc.want, got)
+			require.Equal(t, tc.wantErr, gotErr)
+		})
+	}
+}
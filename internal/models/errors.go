@@ -0,0 +1,127 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/topology"
+	"google.golang.org/grpc/codes"
+)
+
+// Reasons of errors, they are sent in ErrorInfo of gRPC status.
+const (
+	ReasonInvalidArgument  = "INVALID_ARGUMENT"
+	ReasonInvalidPageToken = "INVALID_PAGE_TOKEN"
+	ReasonForbiddenURL     = "FORBIDDEN_URL"
+	ReasonNotFound         = "NOT_FOUND"
+	ReasonLimitExceeded    = "LIMIT_EXCEEDED"
+	ReasonUpstreamStatus   = "UPSTREAM_STATUS"
+	ReasonContentType      = "UNSUPPORTED_CONTENT_TYPE"
+	ReasonEmptyFeed        = "EMPTY_FEED"
+	ReasonTooManyDeleted   = "TOO_MANY_DELETED"
+	ReasonUnavailable      = "UNAVAILABLE"
+	ReasonTimeout          = "TIMEOUT"
+	ReasonCanceled         = "CANCELED"
+	ReasonUnknown          = "UNKNOWN"
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// ErrNotModified is returned by fetch of feed which isn't changed since
+// the last import.
+var ErrNotModified = errors.New("feed not modified")
+
+// ErrForbiddenURL is returned for feed URL blocked by URL policy.
+var ErrForbiddenURL = errors.New("forbidden url")
+
+// ErrLimitExceeded is returned for feed over limits of size or rows.
+var ErrLimitExceeded = errors.New("limit exceeded")
+
+// ErrUnsupportedContentType is returned for feed response with content
+// type which isn't allowed.
+var ErrUnsupportedContentType = errors.New("unsupported content type")
+
+// ErrEmptyFeed is returned for feed without valid prices, unless empty
+// feed is allowed by request.
+var ErrEmptyFeed = errors.New("feed has no prices")
+
+// ErrTooManyDeleted is returned by snapshot import of feed which misses
+// too many prices of the source, prices are kept then.
+var ErrTooManyDeleted = errors.New("too many prices deleted")
+
+// ErrJobLost is returned by update of job whose lease expired and which is
+// claimed again by another worker.
+var ErrJobLost = errors.New("job lock lost")
+
+// FieldError is invalid field of request, Field is named like in proto.
+// Message is the message of Err.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// StatusError is response of feed server with non-2xx status.
+type StatusError struct {
+	StatusCode int
+	Status     string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("feed response status %s", e.Status)
+}
+
+// ErrorCode returns gRPC code of error.
+func ErrorCode(err error) codes.Code {
+	code, _ := ErrorReason(err)
+	return code
+}
+
+// ErrorReason returns gRPC code and reason of error. Errors of requests
+// are InvalidArgument, errors of feed servers and MongoDB depend on
+// failure.
+func ErrorReason(err error) (codes.Code, string) {
+	var fieldErr *FieldError
+	var statusErr *StatusError
+	var netErr net.Error
+	var selectionErr topology.ServerSelectionError
+
+	switch {
+	case err == nil:
+		return codes.OK, ""
+	case errors.Is(err, ErrInvalidPageToken):
+		return codes.InvalidArgument, ReasonInvalidPageToken
+	case errors.Is(err, ErrForbiddenURL):
+		return codes.InvalidArgument, ReasonForbiddenURL
+	case errors.As(err, &fieldErr):
+		return codes.InvalidArgument, ReasonInvalidArgument
+	case errors.Is(err, ErrLimitExceeded):
+		return codes.ResourceExhausted, ReasonLimitExceeded
+	case errors.As(err, &statusErr):
+		return codes.FailedPrecondition, ReasonUpstreamStatus
+	case errors.Is(err, ErrUnsupportedContentType):
+		return codes.FailedPrecondition, ReasonContentType
+	case errors.Is(err, ErrEmptyFeed):
+		return codes.FailedPrecondition, ReasonEmptyFeed
+	case errors.Is(err, ErrTooManyDeleted):
+		return codes.FailedPrecondition, ReasonTooManyDeleted
+	case errors.Is(err, context.DeadlineExceeded) || mongo.IsTimeout(err) || (errors.As(err, &netErr) && netErr.Timeout()):
+		return codes.DeadlineExceeded, ReasonTimeout
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled, ReasonCanceled
+	case mongo.IsNetworkError(err) || errors.As(err, &netErr) || errors.As(err, &selectionErr):
+		return codes.Unavailable, ReasonUnavailable
+	default:
+		return codes.Unknown, ReasonUnknown
+	}
+}
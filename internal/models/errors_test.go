@@ -0,0 +1,118 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCode(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		err error
+
+		want codes.Code
+	}{
+		{
+			name: "No error",
+
+			err: nil,
+
+			want: codes.OK,
+		},
+		{
+			name: "Invalid field",
+
+			err: &FieldError{Field: "url", Err: errors.New(`parse "": empty url`)},
+
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "Invalid page token",
+
+			err: ErrInvalidPageToken,
+
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "Feed response status",
+
+			err: &StatusError{StatusCode: 500, Status: "500 Internal Server Error"},
+
+			want: codes.FailedPrecondition,
+		},
+		{
+			name: "Unsupported content type",
+
+			err: fmt.Errorf("%w %q", ErrUnsupportedContentType, "text/html"),
+
+			want: codes.FailedPrecondition,
+		},
+		{
+			name: "Too many prices deleted",
+
+			err: fmt.Errorf("%w: 3 of 4 prices of source \"shop1\" are missing in feed", ErrTooManyDeleted),
+
+			want: codes.FailedPrecondition,
+		},
+		{
+			name: "Empty feed",
+
+			err: fmt.Errorf("%w: 3 rows rejected", ErrEmptyFeed),
+
+			want: codes.FailedPrecondition,
+		},
+		{
+			name: "Unreachable upstream",
+
+			err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")},
+
+			want: codes.Unavailable,
+		},
+		{
+			name: "Limit exceeded",
+
+			err: fmt.Errorf("%w: feed has more than 10 rows", ErrLimitExceeded),
+
+			want: codes.ResourceExhausted,
+		},
+		{
+			name: "Deadline exceeded",
+
+			err: fmt.Errorf("Get \"http://yandex.ru\": %w", context.DeadlineExceeded),
+
+			want: codes.DeadlineExceeded,
+		},
+		{
+			name: "Canceled",
+
+			err: context.Canceled,
+
+			want: codes.Canceled,
+		},
+		{
+			name: "Other error",
+
+			err: errors.New("some error..."),
+
+			want: codes.Unknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ErrorCode(tc.err)
+
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
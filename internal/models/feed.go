@@ -0,0 +1,205 @@
+package models
+
+import (
+	pb "github.com/roman-wb/price-service/internal/proto"
+)
+
+// Feed formats, empty format is detected by response.
+const (
+	FormatCSV    = "csv"
+	FormatJSON   = "json"
+	FormatNDJSON = "ndjson"
+	FormatXLSX   = "xlsx"
+)
+
+// DefaultMaxDeletedPercent is percent of prices of the source allowed to
+// be deleted by snapshot import if feed doesn't set it.
+const DefaultMaxDeletedPercent = 50
+
+var feedFormats = map[pb.FetchRequest_Format]string{
+	pb.FetchRequest_CSV:    FormatCSV,
+	pb.FetchRequest_JSON:   FormatJSON,
+	pb.FetchRequest_NDJSON: FormatNDJSON,
+	pb.FetchRequest_XLSX:   FormatXLSX,
+}
+
+// Feed describes where and how prices are fetched. Compressed feeds are
+// unpacked, ZipEntry chooses file of zip archive. Currency is set to
+// prices without currency column. Source is the supplier of prices.
+// AllowEmpty allows import of feed without valid prices. Snapshot feed
+// is the full catalog of the source, prices missing in it are deleted
+// unless more than MaxDeletedPercent of them are missing.
+type Feed struct {
+	URL      string       `bson:"url"`
+	Format   string       `bson:"format"`
+	ZipEntry string       `bson:"zip_entry"`
+	Currency string       `bson:"currency"`
+	Source   string       `bson:"source"`
+	CSV      CSVFormat    `bson:"csv"`
+	JSON     JSONFormat   `bson:"json"`
+	XLSX     XLSXFormat   `bson:"xlsx"`
+	Number   NumberFormat `bson:"number"`
+
+	AllowEmpty        bool `bson:"allow_empty"`
+	Snapshot          bool `bson:"snapshot"`
+	MaxDeletedPercent int  `bson:"max_deleted_percent"`
+}
+
+// CSVFormat describes csv file. Columns are numbered from 1, empty
+// fields mean defaults of PRODUCT_NAME;PRICE format.
+type CSVFormat struct {
+	Delimiter      string `bson:"delimiter"`
+	Quote          string `bson:"quote"`
+	Header         bool   `bson:"header"`
+	NameColumn     int    `bson:"name_column"`
+	PriceColumn    int    `bson:"price_column"`
+	CurrencyColumn int    `bson:"currency_column"`
+}
+
+// JSONFormat describes items of json and ndjson feeds. Paths are dotted,
+// empty paths mean fields "name", "price" and "currency".
+type JSONFormat struct {
+	NamePath     string `bson:"name_path"`
+	PricePath    string `bson:"price_path"`
+	CurrencyPath string `bson:"currency_path"`
+}
+
+// XLSXFormat describes spreadsheet. Empty sheet means first sheet,
+// columns are numbered from 1 like in CSVFormat.
+type XLSXFormat struct {
+	Sheet          string `bson:"sheet"`
+	Header         bool   `bson:"header"`
+	NameColumn     int    `bson:"name_column"`
+	PriceColumn    int    `bson:"price_column"`
+	CurrencyColumn int    `bson:"currency_column"`
+}
+
+// NumberFormat describes prices like "1 299,90" or "€12.50". Empty
+// separators mean "." decimal separator without grouping.
+type NumberFormat struct {
+	DecimalSeparator  string   `bson:"decimal_separator"`
+	GroupingSeparator string   `bson:"grouping_separator"`
+	CurrencySymbols   []string `bson:"currency_symbols"`
+}
+
+func FeedFromPB(in *pb.FetchRequest) Feed {
+	feed := Feed{
+		URL:      in.Url,
+		Format:   feedFormats[in.Format],
+		ZipEntry: in.ZipEntry,
+		Currency: in.Currency,
+		Source:   in.Source,
+
+		AllowEmpty:        in.AllowEmpty,
+		Snapshot:          in.Snapshot,
+		MaxDeletedPercent: int(in.MaxDeletedPercent),
+	}
+
+	if in.Csv != nil {
+		feed.CSV = CSVFormat{
+			Delimiter:      in.Csv.Delimiter,
+			Quote:          in.Csv.Quote,
+			Header:         in.Csv.Header,
+			NameColumn:     int(in.Csv.NameColumn),
+			PriceColumn:    int(in.Csv.PriceColumn),
+			CurrencyColumn: int(in.Csv.CurrencyColumn),
+		}
+	}
+
+	if in.Json != nil {
+		feed.JSON = JSONFormat{
+			NamePath:     in.Json.NamePath,
+			PricePath:    in.Json.PricePath,
+			CurrencyPath: in.Json.CurrencyPath,
+		}
+	}
+
+	if in.Xlsx != nil {
+		feed.XLSX = XLSXFormat{
+			Sheet:          in.Xlsx.Sheet,
+			Header:         in.Xlsx.Header,
+			NameColumn:     int(in.Xlsx.NameColumn),
+			PriceColumn:    int(in.Xlsx.PriceColumn),
+			CurrencyColumn: int(in.Xlsx.CurrencyColumn),
+		}
+	}
+
+	if in.NumberFormat != nil {
+		feed.Number = NumberFormat{
+			DecimalSeparator:  in.NumberFormat.DecimalSeparator,
+			GroupingSeparator: in.NumberFormat.GroupingSeparator,
+			CurrencySymbols:   in.NumberFormat.CurrencySymbols,
+		}
+	}
+
+	return feed
+}
+
+// MaxDeleted returns MaxDeletedPercent or DefaultMaxDeletedPercent if it
+// isn't set.
+func (f Feed) MaxDeleted() int {
+	if f.MaxDeletedPercent == 0 {
+		return DefaultMaxDeletedPercent
+	}
+	return f.MaxDeletedPercent
+}
+
+// ToPBFetchRequest is reverse of FeedFromPB, formats without options are
+// left nil.
+func (f Feed) ToPBFetchRequest() *pb.FetchRequest {
+	out := &pb.FetchRequest{
+		Url:      f.URL,
+		ZipEntry: f.ZipEntry,
+		Currency: f.Currency,
+		Source:   f.Source,
+
+		AllowEmpty:        f.AllowEmpty,
+		Snapshot:          f.Snapshot,
+		MaxDeletedPercent: int32(f.MaxDeletedPercent),
+	}
+
+	for format, name := range feedFormats {
+		if name == f.Format {
+			out.Format = format
+		}
+	}
+
+	if f.CSV != (CSVFormat{}) {
+		out.Csv = &pb.FetchRequest_CsvFormat{
+			Delimiter:      f.CSV.Delimiter,
+			Quote:          f.CSV.Quote,
+			Header:         f.CSV.Header,
+			NameColumn:     int32(f.CSV.NameColumn),
+			PriceColumn:    int32(f.CSV.PriceColumn),
+			CurrencyColumn: int32(f.CSV.CurrencyColumn),
+		}
+	}
+
+	if f.JSON != (JSONFormat{}) {
+		out.Json = &pb.FetchRequest_JsonFormat{
+			NamePath:     f.JSON.NamePath,
+			PricePath:    f.JSON.PricePath,
+			CurrencyPath: f.JSON.CurrencyPath,
+		}
+	}
+
+	if f.XLSX != (XLSXFormat{}) {
+		out.Xlsx = &pb.FetchRequest_XlsxFormat{
+			Sheet:          f.XLSX.Sheet,
+			Header:         f.XLSX.Header,
+			NameColumn:     int32(f.XLSX.NameColumn),
+			PriceColumn:    int32(f.XLSX.PriceColumn),
+			CurrencyColumn: int32(f.XLSX.CurrencyColumn),
+		}
+	}
+
+	if f.Number.DecimalSeparator != "" || f.Number.GroupingSeparator != "" || len(f.Number.CurrencySymbols) > 0 {
+		out.NumberFormat = &pb.FetchRequest_NumberFormat{
+			DecimalSeparator:  f.Number.DecimalSeparator,
+			GroupingSeparator: f.Number.GroupingSeparator,
+			CurrencySymbols:   f.Number.CurrencySymbols,
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"reflect"
+	"time"
+)
+
+// FeedState is the last imported version of feed by url. ETag and
+// LastModified make the next request conditional, Hash of body detects
+// unchanged feed of servers without validators.
+type FeedState struct {
+	URL          string    `bson:"url"`
+	Feed         Feed      `bson:"feed"`
+	ETag         string    `bson:"etag"`
+	LastModified string    `bson:"last_modified"`
+	Hash         string    `bson:"hash"`
+	UpdatedAt    time.Time `bson:"updated_at"`
+}
+
+// Matches reports whether state was imported with the same feed options,
+// changed options import feed again.
+func (s *FeedState) Matches(feed Feed) bool {
+	return reflect.DeepEqual(s.Feed, feed)
+}
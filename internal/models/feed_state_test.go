@@ -0,0 +1,14 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedStateMatches(t *testing.T) {
+	state := FeedState{URL: "http://yandex.ru", Feed: Feed{URL: "http://yandex.ru", Source: "shop1"}}
+
+	require.True(t, state.Matches(Feed{URL: "http://yandex.ru", Source: "shop1"}))
+	require.False(t, state.Matches(Feed{URL: "http://yandex.ru", Source: "shop1", Currency: "EUR"}))
+}
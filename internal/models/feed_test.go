@@ -0,0 +1,163 @@
+package models
+
+import (
+	"testing"
+
+	pb "github.com/roman-wb/price-service/internal/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedFromPB(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		in *pb.FetchRequest
+
+		want Feed
+	}{
+		{
+			name: "Only url",
+
+			in: &pb.FetchRequest{Url: "http://yandex.ru"},
+
+			want: Feed{URL: "http://yandex.ru"},
+		},
+		{
+			name: "Url with csv format",
+
+			in: &pb.FetchRequest{
+				Url:      "http://yandex.ru",
+				ZipEntry: "prices.csv",
+				Currency: "EUR",
+				Source:   "shop1",
+				Csv: &pb.FetchRequest_CsvFormat{
+					Delimiter:      ",",
+					Quote:          "'",
+					Header:         true,
+					NameColumn:     2,
+					PriceColumn:    4,
+					CurrencyColumn: 5,
+				},
+			},
+
+			want: Feed{
+				URL:      "http://yandex.ru",
+				ZipEntry: "prices.csv",
+				Currency: "EUR",
+				Source:   "shop1",
+				CSV: CSVFormat{
+					Delimiter:      ",",
+					Quote:          "'",
+					Header:         true,
+					NameColumn:     2,
+					PriceColumn:    4,
+					CurrencyColumn: 5,
+				},
+			},
+		},
+		{
+			name: "Url with json format",
+
+			in: &pb.FetchRequest{
+				Url:    "http://yandex.ru",
+				Format: pb.FetchRequest_NDJSON,
+				Json: &pb.FetchRequest_JsonFormat{
+					NamePath:  "product.title",
+					PricePath: "offer.price",
+				},
+			},
+
+			want: Feed{
+				URL:    "http://yandex.ru",
+				Format: FormatNDJSON,
+				JSON: JSONFormat{
+					NamePath:  "product.title",
+					PricePath: "offer.price",
+				},
+			},
+		},
+		{
+			name: "Url with xlsx format",
+
+			in: &pb.FetchRequest{
+				Url:    "http://yandex.ru",
+				Format: pb.FetchRequest_XLSX,
+				Xlsx: &pb.FetchRequest_XlsxFormat{
+					Sheet:       "Prices",
+					Header:      true,
+					NameColumn:  2,
+					PriceColumn: 3,
+				},
+			},
+
+			want: Feed{
+				URL:    "http://yandex.ru",
+				Format: FormatXLSX,
+				XLSX: XLSXFormat{
+					Sheet:       "Prices",
+					Header:      true,
+					NameColumn:  2,
+					PriceColumn: 3,
+				},
+			},
+		},
+		{
+			name: "Url with number format",
+
+			in: &pb.FetchRequest{
+				Url: "http://yandex.ru",
+				NumberFormat: &pb.FetchRequest_NumberFormat{
+					DecimalSeparator:  ",",
+					GroupingSeparator: " ",
+					CurrencySymbols:   []string{"€", "EUR"},
+				},
+			},
+
+			want: Feed{
+				URL: "http://yandex.ru",
+				Number: NumberFormat{
+					DecimalSeparator:  ",",
+					GroupingSeparator: " ",
+					CurrencySymbols:   []string{"€", "EUR"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := FeedFromPB(tc.in)
+
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestFeedToPBFetchRequest(t *testing.T) {
+	in := &pb.FetchRequest{
+		Url:      "http://yandex.ru",
+		Format:   pb.FetchRequest_JSON,
+		Source:   "shop1",
+		Currency: "EUR",
+		Json:     &pb.FetchRequest_JsonFormat{NamePath: "title"},
+		NumberFormat: &pb.FetchRequest_NumberFormat{
+			DecimalSeparator: ",",
+		},
+		AllowEmpty:        true,
+		Snapshot:          true,
+		MaxDeletedPercent: 20,
+	}
+
+	got := FeedFromPB(in).ToPBFetchRequest()
+
+	require.Equal(t, in, got)
+	require.Equal(t, &pb.FetchRequest{Url: "http://yandex.ru"}, Feed{URL: "http://yandex.ru"}.ToPBFetchRequest())
+}
+
+func TestFeedMaxDeleted(t *testing.T) {
+	require.Equal(t, DefaultMaxDeletedPercent, Feed{Snapshot: true}.MaxDeleted())
+	require.Equal(t, 20, Feed{Snapshot: true, MaxDeletedPercent: 20}.MaxDeleted())
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	pb "github.com/roman-wb/price-service/internal/proto"
+)
+
+type ImportStats struct {
+	Parsed    int      `bson:"parsed"`
+	Rejected  int      `bson:"rejected"`
+	Inserted  int      `bson:"inserted"`
+	Updated   int      `bson:"updated"`
+	Unchanged int      `bson:"unchanged"`
+	Deleted   int      `bson:"deleted"`
+	Rejects   []Reject `bson:"rejects"`
+}
+
+func (s *ImportStats) ToPBImportStats() *pb.ImportStats {
+	rejects := []*pb.ImportStats_Reject{}
+	for _, reject := range s.Rejects {
+		rejects = append(rejects, reject.ToPBImportStatsReject())
+	}
+
+	return &pb.ImportStats{
+		Parsed:    int64(s.Parsed),
+		Rejected:  int64(s.Rejected),
+		Inserted:  int64(s.Inserted),
+		Updated:   int64(s.Updated),
+		Unchanged: int64(s.Unchanged),
+		Deleted:   int64(s.Deleted),
+		Rejects:   rejects,
+	}
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"testing"
+
+	pb "github.com/roman-wb/price-service/internal/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToPBImportStats(t *testing.T) {
+	stats := ImportStats{
+		Parsed:    6,
+		Rejected:  1,
+		Inserted:  1,
+		Updated:   2,
+		Unchanged: 3,
+		Deleted:   4,
+		Rejects: []Reject{
+			{Line: 7, Reason: "wrong number of fields"},
+		},
+	}
+
+	want := &pb.ImportStats{
+		Parsed:    6,
+		Rejected:  1,
+		Inserted:  1,
+		Updated:   2,
+		Unchanged: 3,
+		Deleted:   4,
+		Rejects: []*pb.ImportStats_Reject{
+			{Line: 7, Reason: "wrong number of fields"},
+		},
+	}
+
+	got := stats.ToPBImportStats()
+
+	require.Equal(t, want, got)
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"time"
+
+	pb "github.com/roman-wb/price-service/internal/proto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	JobQueued  = "queued"
+	JobRunning = "running"
+	JobDone    = "done"
+	JobFailed  = "failed"
+)
+
+var jobStates = map[string]pb.Job_State{
+	JobQueued:  pb.Job_QUEUED,
+	JobRunning: pb.Job_RUNNING,
+	JobDone:    pb.Job_DONE,
+	JobFailed:  pb.Job_FAILED,
+}
+
+type Job struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	Feed       Feed               `bson:"feed"`
+	State      string             `bson:"state"`
+	Processed  int                `bson:"processed"`
+	Stats      ImportStats        `bson:"stats"`
+	Error      string             `bson:"error"`
+	Unchanged  bool               `bson:"unchanged"`
+	ErrorCode  string             `bson:"error_code"`
+	Deadline   time.Time          `bson:"deadline"`
+	CreatedAt  time.Time          `bson:"created_at"`
+	StartedAt  time.Time          `bson:"started_at"`
+	FinishedAt time.Time          `bson:"finished_at"`
+
+	// LockedUntil is lease of running job, job of stopped instance is
+	// claimed again after it. LockID is changed by every claim, so worker
+	// of expired lease can't update job claimed again.
+	LockedUntil time.Time          `bson:"locked_until"`
+	LockID      primitive.ObjectID `bson:"lock_id"`
+}
+
+func (j *Job) ToPBJob() *pb.Job {
+	return &pb.Job{
+		Id:         j.ID.Hex(),
+		Url:        j.Feed.URL,
+		State:      jobStates[j.State],
+		Processed:  int64(j.Processed),
+		Stats:      j.Stats.ToPBImportStats(),
+		Error:      j.Error,
+		Unchanged:  j.Unchanged,
+		ErrorCode:  j.ErrorCode,
+		Deadline:   toPBTimestamp(j.Deadline),
+		CreatedAt:  toPBTimestamp(j.CreatedAt),
+		StartedAt:  toPBTimestamp(j.StartedAt),
+		FinishedAt: toPBTimestamp(j.FinishedAt),
+	}
+}
+
+func toPBTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,85 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/roman-wb/price-service/internal/proto"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestToPBJob(t *testing.T) {
+	now := time.Now().UTC()
+	id := primitive.NewObjectID()
+
+	testCases := []struct {
+		name string
+
+		job Job
+
+		want *pb.Job
+	}{
+		{
+			name: "Queued job",
+
+			job: Job{
+				ID:        id,
+				Feed:      Feed{URL: "http://yandex.ru/price"},
+				State:     JobQueued,
+				CreatedAt: now,
+			},
+
+			want: &pb.Job{
+				Id:        id.Hex(),
+				Url:       "http://yandex.ru/price",
+				State:     pb.Job_QUEUED,
+				Stats:     &pb.ImportStats{Rejects: []*pb.ImportStats_Reject{}},
+				CreatedAt: timestamppb.New(now),
+			},
+		},
+		{
+			name: "Failed job",
+
+			job: Job{
+				ID:         id,
+				Feed:       Feed{URL: "http://yandex.ru/price"},
+				State:      JobFailed,
+				Processed:  10,
+				Stats:      ImportStats{Parsed: 10},
+				Error:      "some error...",
+				ErrorCode:  "DeadlineExceeded",
+				Deadline:   now,
+				CreatedAt:  now,
+				StartedAt:  now,
+				FinishedAt: now,
+			},
+
+			want: &pb.Job{
+				Id:         id.Hex(),
+				Url:        "http://yandex.ru/price",
+				State:      pb.Job_FAILED,
+				Processed:  10,
+				Stats:      &pb.ImportStats{Parsed: 10, Rejects: []*pb.ImportStats_Reject{}},
+				Error:      "some error...",
+				ErrorCode:  "DeadlineExceeded",
+				Deadline:   timestamppb.New(now),
+				CreatedAt:  timestamppb.New(now),
+				StartedAt:  timestamppb.New(now),
+				FinishedAt: timestamppb.New(now),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.job.ToPBJob()
+
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
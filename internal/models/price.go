@@ -1,6 +1,8 @@
 package models
 
 import (
+	"fmt"
+	"math/big"
 	"time"
 
 	pb "github.com/roman-wb/price-service/internal/proto"
@@ -9,18 +11,55 @@ import (
 )
 
 type Price struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty"`
-	Name      string             `bson:"name"`
-	Price     float64            `bson:"price"`
-	Changes   int                `bson:"changes"`
-	UpdatedAt time.Time          `bson:"updated_at"`
+	ID        primitive.ObjectID   `bson:"_id,omitempty"`
+	Name      string               `bson:"name"`
+	Price     primitive.Decimal128 `bson:"price"`
+	Currency  string               `bson:"currency"`
+	Source    string               `bson:"source"`
+	Changes   int                  `bson:"changes"`
+	UpdatedAt time.Time            `bson:"updated_at"`
 }
 
 func (p *Price) ToPBListReplyPrice() *pb.ListReply_Price {
 	return &pb.ListReply_Price{
 		Name:      p.Name,
-		Price:     p.Price,
+		Price:     p.Price.String(),
+		Currency:  p.Currency,
+		Source:    p.Source,
 		Changes:   int64(p.Changes),
 		UpdatedAt: timestamppb.New(p.UpdatedAt),
 	}
 }
+
+// ParsePrice parses exact decimal price like "12.50". Positive exponent
+// is expanded, so "1.1E+3" is kept as "1100".
+func ParsePrice(s string) (primitive.Decimal128, error) {
+	price, err := primitive.ParseDecimal128(s)
+	if err != nil || price.IsNaN() || price.IsInf() != 0 {
+		return primitive.Decimal128{}, fmt.Errorf("invalid price %q", s)
+	}
+
+	bi, exp, err := price.BigInt()
+	if err != nil {
+		return primitive.Decimal128{}, fmt.Errorf("invalid price %q", s)
+	}
+	if exp > 0 {
+		bi.Mul(bi, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil))
+		var ok bool
+		price, ok = primitive.ParseDecimal128FromBigInt(bi, 0)
+		if !ok {
+			return primitive.Decimal128{}, fmt.Errorf("invalid price %q", s)
+		}
+	}
+
+	return price, nil
+}
+
+// MustParsePrice is like ParsePrice but panics if price is invalid.
+func MustParsePrice(s string) primitive.Decimal128 {
+	price, err := ParsePrice(s)
+	if err != nil {
+		panic(err)
+	}
+	return price
+}
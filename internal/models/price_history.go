@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	pb "github.com/roman-wb/price-service/internal/proto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PriceHistory struct {
+	ID        primitive.ObjectID   `bson:"_id,omitempty"`
+	Name      string               `bson:"name"`
+	Price     primitive.Decimal128 `bson:"price"`
+	Currency  string               `bson:"currency"`
+	Source    string               `bson:"source"`
+	CreatedAt time.Time            `bson:"created_at"`
+}
+
+func (h *PriceHistory) ToPBGetHistoryReplyPrice() *pb.GetHistoryReply_Price {
+	return &pb.GetHistoryReply_Price{
+		Price:     h.Price.String(),
+		Currency:  h.Currency,
+		Source:    h.Source,
+		CreatedAt: timestamppb.New(h.CreatedAt),
+	}
+}
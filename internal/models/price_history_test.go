@@ -0,0 +1,28 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/roman-wb/price-service/internal/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestToPBGetHistoryReplyPrice(t *testing.T) {
+	now := time.Now().UTC()
+	history := PriceHistory{
+		Name:      "Product",
+		Price:     MustParsePrice("100.99"),
+		CreatedAt: now,
+	}
+
+	want := &pb.GetHistoryReply_Price{
+		Price:     "100.99",
+		CreatedAt: timestamppb.New(now),
+	}
+
+	got := history.ToPBGetHistoryReplyPrice()
+
+	require.Equal(t, want, got)
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PriceQuery describes requested page of prices. PageToken is returned by
+// previous page and continues the list right after its last price.
+// WithTotal requests count of all prices matched by the filter. Currency
+// converts prices by rates, prices without rate are skipped then.
+// BestPrice keeps the lowest price of every product across sources.
+type PriceQuery struct {
+	Skip      int
+	Limit     int
+	OrderBy   string
+	OrderType int32
+	PageToken string
+	Filter    PriceFilter
+	WithTotal bool
+	Currency  string
+	BestPrice bool
+}
+
+// PriceFilter limits listed prices. Empty fields don't filter, ranges
+// include min values and exclude UpdatedTo.
+type PriceFilter struct {
+	Source       string
+	NamePrefix   string
+	NameContains string
+	MinPrice     *primitive.Decimal128
+	MaxPrice     *primitive.Decimal128
+	MinChanges   *int64
+	MaxChanges   *int64
+	UpdatedFrom  time.Time
+	UpdatedTo    time.Time
+}
+
+type PricePage struct {
+	Prices        []Price
+	NextPageToken string
+	Total         *int64
+}
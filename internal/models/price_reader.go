@@ -0,0 +1,9 @@
+package models
+
+// PriceReader is a stream of parsed prices. Read returns io.EOF when the
+// stream is over, Stats describes parsed and rejected rows read so far.
+type PriceReader interface {
+	Read() (Price, error)
+	Stats() ImportStats
+	Close() error
+}
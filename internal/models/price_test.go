@@ -1,6 +1,7 @@
 package models
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -13,14 +14,14 @@ func TestToPBListReplyPrice(t *testing.T) {
 	now := time.Now().UTC()
 	price := Price{
 		Name:      "Product",
-		Price:     100.99,
+		Price:     MustParsePrice("100.99"),
 		Changes:   11,
 		UpdatedAt: now,
 	}
 
 	want := &pb.ListReply_Price{
 		Name:      "Product",
-		Price:     100.99,
+		Price:     "100.99",
 		Changes:   int64(11),
 		UpdatedAt: timestamppb.New(now),
 	}
@@ -29,3 +30,36 @@ func TestToPBListReplyPrice(t *testing.T) {
 
 	require.Equal(t, want, got)
 }
+
+func TestParsePrice(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		s string
+
+		want    string
+		wantErr error
+	}{
+		{name: "Keeps scale", s: "12.50", want: "12.50"},
+		{name: "Negative", s: "-0.99", want: "-0.99"},
+		{name: "Expands exponent", s: "1.1E+3", want: "1100"},
+		{name: "Small exponent", s: "15e-1", want: "1.5"},
+		{name: "Invalid", s: "1,5", wantErr: errors.New(`invalid price "1,5"`)},
+		{name: "NaN", s: "NaN", wantErr: errors.New(`invalid price "NaN"`)},
+		{name: "Infinity", s: "-inf", wantErr: errors.New(`invalid price "-inf"`)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotErr := ParsePrice(tc.s)
+
+			require.Equal(t, tc.wantErr, gotErr)
+			if tc.wantErr == nil {
+				require.Equal(t, tc.want, got.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Rate is count of currency units for one unit of base currency. All
+// rates have the same base, so price is converted from currency A to B
+// as price * B.Rate / A.Rate.
+type Rate struct {
+	Currency  string               `bson:"currency"`
+	Rate      primitive.Decimal128 `bson:"rate"`
+	UpdatedAt time.Time            `bson:"updated_at"`
+}
+
+// ParseRate parses positive decimal rate like "0.85".
+func ParseRate(s string) (primitive.Decimal128, error) {
+	rate, err := ParsePrice(s)
+	if err != nil {
+		return rate, fmt.Errorf("invalid rate %q", s)
+	}
+
+	bi, _, err := rate.BigInt()
+	if err != nil || bi.Sign() <= 0 {
+		return primitive.Decimal128{}, fmt.Errorf("invalid rate %q", s)
+	}
+
+	return rate, nil
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestParseRate(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		s string
+
+		want    primitive.Decimal128
+		wantErr error
+	}{
+		{name: "Decimal", s: "0.85", want: MustParsePrice("0.85")},
+		{name: "Exponent", s: "7.35E+1", want: MustParsePrice("73.5")},
+		{name: "Zero", s: "0.00", wantErr: errors.New(`invalid rate "0.00"`)},
+		{name: "Negative", s: "-1", wantErr: errors.New(`invalid rate "-1"`)},
+		{name: "Text", s: "one", wantErr: errors.New(`invalid rate "one"`)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotErr := ParseRate(tc.s)
+
+			require.Equal(t, tc.want, got)
+			require.Equal(t, tc.wantErr, gotErr)
+		})
+	}
+}
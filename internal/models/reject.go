@@ -0,0 +1,17 @@
+package models
+
+import (
+	pb "github.com/roman-wb/price-service/internal/proto"
+)
+
+type Reject struct {
+	Line   int    `bson:"line"`
+	Reason string `bson:"reason"`
+}
+
+func (r *Reject) ToPBImportStatsReject() *pb.ImportStats_Reject {
+	return &pb.ImportStats_Reject{
+		Line:   int64(r.Line),
+		Reason: r.Reason,
+	}
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"testing"
+
+	pb "github.com/roman-wb/price-service/internal/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToPBImportStatsReject(t *testing.T) {
+	reject := Reject{
+		Line:   10,
+		Reason: "wrong number of fields",
+	}
+
+	want := &pb.ImportStats_Reject{
+		Line:   10,
+		Reason: "wrong number of fields",
+	}
+
+	got := reject.ToPBImportStatsReject()
+
+	require.Equal(t, want, got)
+}
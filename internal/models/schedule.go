@@ -0,0 +1,80 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	pb "github.com/roman-wb/price-service/internal/proto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// MinScheduleInterval caps how often a feed is fetched by interval.
+const MinScheduleInterval = time.Minute
+
+// Schedule fetches the feed by standard cron expression in UTC or every
+// interval, only one of them is set. NextRunAt is moved forward by the
+// instance that runs the schedule, so every run is fired once.
+type Schedule struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Feed      Feed               `bson:"feed"`
+	Cron      string             `bson:"cron"`
+	Interval  time.Duration      `bson:"interval"`
+	NextRunAt time.Time          `bson:"next_run_at"`
+	LastRunAt time.Time          `bson:"last_run_at"`
+	LastJobID primitive.ObjectID `bson:"last_job_id,omitempty"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+// Validate checks cron expression or interval, errors are FieldError.
+func (s *Schedule) Validate() error {
+	if s.Cron == "" && s.Interval == 0 {
+		return &FieldError{Field: "cron", Err: errors.New("cron or interval is required")}
+	}
+	if s.Cron != "" && s.Interval != 0 {
+		return &FieldError{Field: "interval", Err: errors.New("only one of cron and interval is allowed")}
+	}
+	if s.Cron != "" {
+		_, err := cron.ParseStandard(s.Cron)
+		if err != nil {
+			return &FieldError{Field: "cron", Err: fmt.Errorf("invalid cron %q: %v", s.Cron, err)}
+		}
+	}
+	if s.Interval != 0 && s.Interval < MinScheduleInterval {
+		return &FieldError{Field: "interval", Err: fmt.Errorf("interval is less than %s", MinScheduleInterval)}
+	}
+	return nil
+}
+
+// Next returns time of the run after t, zero time if schedule is invalid.
+func (s *Schedule) Next(t time.Time) time.Time {
+	if s.Interval > 0 {
+		return t.Add(s.Interval)
+	}
+
+	schedule, err := cron.ParseStandard(s.Cron)
+	if err != nil {
+		return time.Time{}
+	}
+	return schedule.Next(t.UTC())
+}
+
+func (s *Schedule) ToPBSchedule() *pb.Schedule {
+	out := &pb.Schedule{
+		Id:        s.ID.Hex(),
+		Feed:      s.Feed.ToPBFetchRequest(),
+		Cron:      s.Cron,
+		NextRunAt: toPBTimestamp(s.NextRunAt),
+		LastRunAt: toPBTimestamp(s.LastRunAt),
+		CreatedAt: toPBTimestamp(s.CreatedAt),
+	}
+	if s.Interval > 0 {
+		out.Interval = durationpb.New(s.Interval)
+	}
+	if !s.LastJobID.IsZero() {
+		out.LastJobId = s.LastJobID.Hex()
+	}
+	return out
+}
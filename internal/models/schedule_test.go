@@ -0,0 +1,125 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/roman-wb/price-service/internal/proto"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestScheduleValidate(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		schedule Schedule
+
+		wantErr error
+	}{
+		{
+			name: "Empty",
+
+			schedule: Schedule{},
+
+			wantErr: &FieldError{Field: "cron", Err: errors.New("cron or interval is required")},
+		},
+		{
+			name: "Cron and interval",
+
+			schedule: Schedule{Cron: "* * * * *", Interval: time.Hour},
+
+			wantErr: &FieldError{Field: "interval", Err: errors.New("only one of cron and interval is allowed")},
+		},
+		{
+			name: "Invalid cron",
+
+			schedule: Schedule{Cron: "every day"},
+
+			wantErr: &FieldError{Field: "cron", Err: errors.New(`invalid cron "every day": expected exactly 5 fields, found 2: [every day]`)},
+		},
+		{
+			name: "Short interval",
+
+			schedule: Schedule{Interval: time.Second},
+
+			wantErr: &FieldError{Field: "interval", Err: errors.New("interval is less than 1m0s")},
+		},
+		{
+			name: "Valid cron",
+
+			schedule: Schedule{Cron: "0 */6 * * *"},
+
+			wantErr: nil,
+		},
+		{
+			name: "Valid interval",
+
+			schedule: Schedule{Interval: time.Hour},
+
+			wantErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotErr := tc.schedule.Validate()
+
+			require.Equal(t, tc.wantErr, gotErr)
+		})
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	now := time.Date(2021, 7, 28, 10, 30, 15, 0, time.UTC)
+
+	cron := Schedule{Cron: "0 */6 * * *"}
+	require.Equal(t, time.Date(2021, 7, 28, 12, 0, 0, 0, time.UTC), cron.Next(now))
+
+	interval := Schedule{Interval: time.Hour}
+	require.Equal(t, now.Add(time.Hour), interval.Next(now))
+
+	invalid := Schedule{Cron: "invalid"}
+	require.True(t, invalid.Next(now).IsZero())
+}
+
+func TestToPBSchedule(t *testing.T) {
+	now := time.Now().UTC()
+	id := primitive.NewObjectID()
+	jobID := primitive.NewObjectID()
+
+	schedule := Schedule{
+		ID:        id,
+		Feed:      Feed{URL: "http://yandex.ru/price"},
+		Interval:  time.Hour,
+		NextRunAt: now.Add(time.Hour),
+		LastRunAt: now,
+		LastJobID: jobID,
+		CreatedAt: now,
+	}
+
+	require.Equal(t, &pb.Schedule{
+		Id:        id.Hex(),
+		Feed:      &pb.FetchRequest{Url: "http://yandex.ru/price"},
+		Interval:  durationpb.New(time.Hour),
+		NextRunAt: timestamppb.New(now.Add(time.Hour)),
+		LastRunAt: timestamppb.New(now),
+		LastJobId: jobID.Hex(),
+		CreatedAt: timestamppb.New(now),
+	}, schedule.ToPBSchedule())
+
+	schedule = Schedule{ID: id, Feed: Feed{URL: "http://yandex.ru/price"}, Cron: "0 * * * *", CreatedAt: now}
+
+	require.Equal(t, &pb.Schedule{
+		Id:        id.Hex(),
+		Feed:      &pb.FetchRequest{Url: "http://yandex.ru/price"},
+		Cron:      "0 * * * *",
+		CreatedAt: timestamppb.New(now),
+	}, schedule.ToPBSchedule())
+}
@@ -0,0 +1,44 @@
+package parser
+
+import "fmt"
+
+// columns are indexes of name, price and currency fields in row,
+// numbered from 0. Currency is -1 if row has no currency.
+type columns struct {
+	name     int
+	price    int
+	currency int
+}
+
+// newColumns validates columns numbered from 1, zero means default
+// first and second columns and no currency column.
+func newColumns(name, price, currency int) (columns, error) {
+	cols := columns{name: 0, price: 1, currency: currency - 1}
+
+	if name < 0 || price < 0 || currency < 0 {
+		return cols, fmt.Errorf("invalid columns %d, %d and %d", name, price, currency)
+	}
+	if name > 0 {
+		cols.name = name - 1
+	}
+	if price > 0 {
+		cols.price = price - 1
+	}
+	if cols.name == cols.price || cols.name == cols.currency || cols.price == cols.currency {
+		return cols, fmt.Errorf("name, price and currency columns are the same")
+	}
+
+	return cols, nil
+}
+
+// fields is minimal count of fields in row.
+func (c columns) fields() int {
+	max := c.name
+	if c.price > max {
+		max = c.price
+	}
+	if c.currency > max {
+		max = c.currency
+	}
+	return max + 1
+}
@@ -0,0 +1,155 @@
+package parser
+
+import (
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/roman-wb/price-service/internal/models"
+)
+
+const (
+	compressionGzip = "gzip"
+	compressionZip  = "zip"
+)
+
+var contentTypeCompressions = map[string]string{
+	"application/gzip":             compressionGzip,
+	"application/x-gzip":           compressionGzip,
+	"application/zip":              compressionZip,
+	"application/x-zip-compressed": compressionZip,
+}
+
+var extensionCompressions = map[string]string{
+	".gz":  compressionGzip,
+	".zip": compressionZip,
+}
+
+// compressedBody is decompressed feed, closing it closes every reader
+// it was made of.
+type compressedBody struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (b *compressedBody) Close() error {
+	var err error
+	for i := len(b.closers) - 1; i >= 0; i-- {
+		closeErr := b.closers[i].Close()
+		if err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// feedName is path of url, which is used for detection of format.
+func feedName(rawurl string) string {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return ""
+	}
+	return u.Path
+}
+
+// detectCompression chooses compression of feed by Content-Encoding,
+// Content-Type or extension of url. Xlsx is zip too, so Content-Type of
+// zip is ignored for it.
+func detectCompression(feed models.Feed, header http.Header) string {
+	encoding := strings.ToLower(header.Get("Content-Encoding"))
+	if encoding == "gzip" || encoding == "x-gzip" {
+		return compressionGzip
+	}
+
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err == nil {
+		compression, ok := contentTypeCompressions[mediaType]
+		if ok && (compression != compressionZip || feed.Format != models.FormatXLSX) {
+			return compression
+		}
+	}
+
+	return extensionCompressions[strings.ToLower(path.Ext(feedName(feed.URL)))]
+}
+
+// decompress unpacks body and returns name of unpacked file, so format
+// can be detected by extension. Body is returned as is without
+// compression.
+func decompress(body io.ReadCloser, compression string, name string, zipEntry string) (io.ReadCloser, string, error) {
+	switch compression {
+	case compressionGzip:
+		reader, err := gzip.NewReader(body)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid gzip: %v", err)
+		}
+
+		if strings.ToLower(path.Ext(name)) == ".gz" {
+			name = strings.TrimSuffix(name, path.Ext(name))
+		}
+		return &compressedBody{Reader: reader, closers: []io.Closer{body, reader}}, name, nil
+	case compressionZip:
+		// Downloaded body is a temp file already
+		file, ok := body.(*tempFile)
+		if !ok {
+			var err error
+			file, err = newTempFile(body, "price-*.zip")
+			if err != nil {
+				return nil, "", err
+			}
+			body = &compressedBody{Reader: file, closers: []io.Closer{body, file}}
+		}
+
+		entry, err := unzipEntry(file, zipEntry)
+		if err != nil {
+			if !ok {
+				file.Close()
+			}
+			return nil, "", err
+		}
+
+		reader, err := entry.Open()
+		if err != nil {
+			if !ok {
+				file.Close()
+			}
+			return nil, "", err
+		}
+		return &compressedBody{Reader: reader, closers: []io.Closer{body, reader}}, entry.Name, nil
+	default:
+		return body, name, nil
+	}
+}
+
+// unzipEntry finds entry by name, or the only file of archive if name is
+// empty. Directories and metadata of macOS are skipped.
+func unzipEntry(file *tempFile, name string) (*zip.File, error) {
+	archive, err := zip.NewReader(file, file.size)
+	if err != nil {
+		return nil, fmt.Errorf("invalid zip: %v", err)
+	}
+
+	var entries []*zip.File
+	for _, f := range archive.File {
+		if name != "" && f.Name == name {
+			return f, nil
+		}
+		if f.FileInfo().IsDir() || strings.HasPrefix(f.Name, "__MACOSX/") {
+			continue
+		}
+		entries = append(entries, f)
+	}
+
+	if name != "" {
+		return nil, fmt.Errorf("zip entry %q not found", name)
+	}
+	if len(entries) != 1 {
+		return nil, fmt.Errorf("zip has %d files, entry name is required", len(entries))
+	}
+	return entries[0], nil
+}
@@ -0,0 +1,198 @@
+package parser
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/roman-wb/price-service/internal/models"
+	"github.com/stretchr/testify/require"
+)
+
+func gzipData(t *testing.T, data string) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write([]byte(data))
+	require.Nil(t, err)
+	require.Nil(t, w.Close())
+	return buf.Bytes()
+}
+
+// zipData makes archive of files, name and data go in pairs.
+func zipData(t *testing.T, files ...string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for i := 0; i < len(files); i += 2 {
+		f, err := w.Create(files[i])
+		require.Nil(t, err)
+		_, err = f.Write([]byte(files[i+1]))
+		require.Nil(t, err)
+	}
+	require.Nil(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestDetectCompression(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		feed   models.Feed
+		header http.Header
+
+		want string
+	}{
+		{
+			name: "Content-Encoding",
+
+			feed:   models.Feed{URL: "http://yandex.ru/price.zip"},
+			header: http.Header{"Content-Encoding": []string{"gzip"}},
+
+			want: compressionGzip,
+		},
+		{
+			name: "Content-Type",
+
+			feed:   models.Feed{URL: "http://yandex.ru/price"},
+			header: http.Header{"Content-Type": []string{"application/zip"}},
+
+			want: compressionZip,
+		},
+		{
+			name: "Content-Type of zip for xlsx",
+
+			feed:   models.Feed{URL: "http://yandex.ru/price", Format: models.FormatXLSX},
+			header: http.Header{"Content-Type": []string{"application/zip"}},
+
+			want: "",
+		},
+		{
+			name: "Extension",
+
+			feed:   models.Feed{URL: "http://yandex.ru/price.csv.GZ?date=today"},
+			header: http.Header{"Content-Type": []string{"application/octet-stream"}},
+
+			want: compressionGzip,
+		},
+		{
+			name: "Without compression",
+
+			feed:   models.Feed{URL: "http://yandex.ru/price.csv"},
+			header: nil,
+
+			want: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := detectCompression(tc.feed, tc.header)
+
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestDecompress(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		data        []byte
+		compression string
+		feedName    string
+		zipEntry    string
+
+		wantData string
+		wantName string
+		wantErr  error
+	}{
+		{
+			name: "Gzip",
+
+			data:        gzipData(t, "Product 1;1"),
+			compression: compressionGzip,
+			feedName:    "/price.csv.gz",
+
+			wantData: "Product 1;1",
+			wantName: "/price.csv",
+		},
+		{
+			name: "Invalid gzip",
+
+			data:        []byte("Product 1;1"),
+			compression: compressionGzip,
+			feedName:    "/price.csv.gz",
+
+			wantErr: errors.New("invalid gzip: gzip: invalid header"),
+		},
+		{
+			name: "Zip with one file",
+
+			data:        zipData(t, "prices/", "", "__MACOSX/prices/._price.json", "meta", "prices/price.json", "[]"),
+			compression: compressionZip,
+			feedName:    "/price.zip",
+
+			wantData: "[]",
+			wantName: "prices/price.json",
+		},
+		{
+			name: "Zip entry by name",
+
+			data:        zipData(t, "price.csv", "Product 1;1", "price.json", "[]"),
+			compression: compressionZip,
+			feedName:    "/price.zip",
+			zipEntry:    "price.csv",
+
+			wantData: "Product 1;1",
+			wantName: "price.csv",
+		},
+		{
+			name: "Zip with several files",
+
+			data:        zipData(t, "price.csv", "Product 1;1", "price.json", "[]"),
+			compression: compressionZip,
+			feedName:    "/price.zip",
+
+			wantErr: errors.New("zip has 2 files, entry name is required"),
+		},
+		{
+			name: "Zip entry not found",
+
+			data:        zipData(t, "price.csv", "Product 1;1"),
+			compression: compressionZip,
+			feedName:    "/price.zip",
+			zipEntry:    "price.json",
+
+			wantErr: errors.New(`zip entry "price.json" not found`),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			body := ioutil.NopCloser(bytes.NewReader(tc.data))
+
+			gotBody, gotName, gotErr := decompress(body, tc.compression, tc.feedName, tc.zipEntry)
+			if tc.wantErr != nil {
+				require.Nil(t, gotBody)
+				require.Equal(t, tc.wantErr.Error(), gotErr.Error())
+				return
+			}
+			require.Nil(t, gotErr)
+			defer gotBody.Close()
+
+			gotData, err := ioutil.ReadAll(gotBody)
+			require.Nil(t, err)
+			require.Equal(t, tc.wantData, string(gotData))
+			require.Equal(t, tc.wantName, gotName)
+		})
+	}
+}
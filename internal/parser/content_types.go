@@ -0,0 +1,39 @@
+package parser
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+
+	"github.com/roman-wb/price-service/internal/models"
+)
+
+// ContentTypes are media types allowed in Content-Type of feed responses,
+// like "text/csv" or "application/*". Empty ContentTypes allow any
+// response, even without Content-Type.
+type ContentTypes []string
+
+// Check returns models.ErrUnsupportedContentType if content type of
+// response isn't allowed. Parameters like charset are ignored.
+func (c ContentTypes) Check(contentType string) error {
+	if len(c) == 0 {
+		return nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("%w %q", models.ErrUnsupportedContentType, contentType)
+	}
+
+	for _, allowed := range c {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		if allowed == mediaType {
+			return nil
+		}
+		if strings.HasSuffix(allowed, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(allowed, "*")) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w %q", models.ErrUnsupportedContentType, mediaType)
+}
@@ -0,0 +1,76 @@
+package parser
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/roman-wb/price-service/internal/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentTypesCheck(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		contentTypes ContentTypes
+		contentType  string
+
+		wantErr error
+	}{
+		{
+			name: "Any content type",
+
+			contentTypes: nil,
+			contentType:  "",
+
+			wantErr: nil,
+		},
+		{
+			name: "Allowed media type",
+
+			contentTypes: ContentTypes{"text/csv"},
+			contentType:  "Text/CSV; charset=utf-8",
+
+			wantErr: nil,
+		},
+		{
+			name: "Allowed by wildcard",
+
+			contentTypes: ContentTypes{"application/*"},
+			contentType:  "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+
+			wantErr: nil,
+		},
+		{
+			name: "Not allowed media type",
+
+			contentTypes: ContentTypes{"text/csv", "application/*"},
+			contentType:  "text/html",
+
+			wantErr: errors.New(`unsupported content type "text/html"`),
+		},
+		{
+			name: "Missing content type",
+
+			contentTypes: ContentTypes{"text/csv"},
+			contentType:  "",
+
+			wantErr: errors.New(`unsupported content type ""`),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotErr := tc.contentTypes.Check(tc.contentType)
+			if tc.wantErr == nil {
+				require.Nil(t, gotErr)
+				return
+			}
+			require.True(t, errors.Is(gotErr, models.ErrUnsupportedContentType))
+			require.Equal(t, tc.wantErr.Error(), gotErr.Error())
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package parser
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+type csvDecoder struct {
+	opts    csvOptions
+	records *recordReader
+	started bool
+}
+
+func newCSVDecoder(r io.Reader, opts csvOptions) *csvDecoder {
+	return &csvDecoder{
+		opts:    opts,
+		records: newRecordReader(r, opts),
+	}
+}
+
+func (d *csvDecoder) Decode() (row, error) {
+	if !d.started {
+		d.started = true
+		if d.opts.header {
+			_, _, err := d.records.Read()
+			if _, ok := err.(*csv.ParseError); err != nil && !ok {
+				return row{}, err
+			}
+		}
+	}
+
+	line, record, err := d.records.Read()
+	if parseErr, ok := err.(*csv.ParseError); ok {
+		return row{}, &rowError{line: line, reason: parseErr.Err.Error()}
+	}
+	if err != nil {
+		return row{}, err
+	}
+
+	if len(record) < d.opts.columns.fields() {
+		return row{}, &rowError{line: line, reason: csv.ErrFieldCount.Error()}
+	}
+
+	r := row{
+		line:  line,
+		name:  record[d.opts.columns.name],
+		price: record[d.opts.columns.price],
+	}
+	if d.opts.columns.currency >= 0 {
+		r.currency = record[d.opts.columns.currency]
+	}
+	return r, nil
+}
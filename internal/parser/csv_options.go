@@ -0,0 +1,53 @@
+package parser
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/roman-wb/price-service/internal/models"
+)
+
+// csvOptions is validated models.CSVFormat with defaults, columns are
+// numbered from 0.
+type csvOptions struct {
+	comma   rune
+	quote   rune
+	header  bool
+	columns columns
+}
+
+func newCSVOptions(format models.CSVFormat) (csvOptions, error) {
+	opts := csvOptions{
+		comma:  ';',
+		quote:  '"',
+		header: format.Header,
+	}
+
+	if format.Delimiter != "" {
+		comma, size := utf8.DecodeRuneInString(format.Delimiter)
+		if size != len(format.Delimiter) || comma == utf8.RuneError || comma == '"' || comma == '\r' || comma == '\n' {
+			return opts, fmt.Errorf("invalid csv delimiter %q", format.Delimiter)
+		}
+		opts.comma = comma
+	}
+
+	if format.Quote != "" {
+		quote := rune(format.Quote[0])
+		if len(format.Quote) != 1 || quote >= utf8.RuneSelf || quote == '\r' || quote == '\n' {
+			return opts, fmt.Errorf("invalid csv quote %q", format.Quote)
+		}
+		opts.quote = quote
+	}
+
+	if opts.quote == opts.comma {
+		return opts, fmt.Errorf("csv quote and delimiter are the same %q", string(opts.comma))
+	}
+
+	cols, err := newColumns(format.NameColumn, format.PriceColumn, format.CurrencyColumn)
+	if err != nil {
+		return opts, fmt.Errorf("csv %v", err)
+	}
+	opts.columns = cols
+
+	return opts, nil
+}
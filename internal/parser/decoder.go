@@ -0,0 +1,115 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"path"
+	"strings"
+
+	"github.com/roman-wb/price-service/internal/models"
+)
+
+// row is a raw product of feed, Reader validates name, price and
+// currency. Number is set if price is typed number of feed, not text in
+// number format.
+type row struct {
+	line     int
+	name     string
+	price    string
+	number   bool
+	currency string
+}
+
+// decoder reads rows of one feed format. *rowError rejects the row and
+// reading goes on, any other error stops reading. Decoder holding
+// resources implements io.Closer.
+type decoder interface {
+	Decode() (row, error)
+}
+
+type rowError struct {
+	line   int
+	reason string
+}
+
+func (e *rowError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.line, e.reason)
+}
+
+var contentTypeFormats = map[string]string{
+	"text/csv":             models.FormatCSV,
+	"application/csv":      models.FormatCSV,
+	"application/json":     models.FormatJSON,
+	"text/json":            models.FormatJSON,
+	"application/x-ndjson": models.FormatNDJSON,
+	"application/ndjson":   models.FormatNDJSON,
+	"application/jsonl":    models.FormatNDJSON,
+
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet": models.FormatXLSX,
+}
+
+var extensionFormats = map[string]string{
+	".csv":    models.FormatCSV,
+	".json":   models.FormatJSON,
+	".ndjson": models.FormatNDJSON,
+	".jsonl":  models.FormatNDJSON,
+	".xlsx":   models.FormatXLSX,
+}
+
+func validateFormat(format string) error {
+	switch format {
+	case "", models.FormatCSV, models.FormatJSON, models.FormatNDJSON, models.FormatXLSX:
+		return nil
+	}
+	return fmt.Errorf("unknown feed format %q", format)
+}
+
+// detectFormat chooses format of feed: explicit format, then Content-Type
+// of response, then extension of file name. Csv is default.
+func detectFormat(format string, contentType string, name string) string {
+	if format != "" {
+		return format
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err == nil {
+		if format, ok := contentTypeFormats[mediaType]; ok {
+			return format
+		}
+	}
+
+	if format, ok := extensionFormats[strings.ToLower(path.Ext(name))]; ok {
+		return format
+	}
+
+	return models.FormatCSV
+}
+
+// newDecoder returns decoder of format, maxBytes limits files unpacked
+// from xlsx.
+func newDecoder(r io.Reader, format string, feed models.Feed, maxBytes int64) (decoder, error) {
+	switch format {
+	case models.FormatJSON, models.FormatNDJSON:
+		opts, err := newJSONOptions(feed.JSON)
+		if err != nil {
+			return nil, err
+		}
+		if format == models.FormatJSON {
+			return newJSONDecoder(r, opts), nil
+		}
+		return newNDJSONDecoder(r, opts), nil
+	case models.FormatXLSX:
+		opts, err := newXLSXOptions(feed.XLSX)
+		if err != nil {
+			return nil, err
+		}
+		return newXLSXDecoder(r, opts, maxBytes)
+	default:
+		opts, err := newCSVOptions(feed.CSV)
+		if err != nil {
+			return nil, err
+		}
+		return newCSVDecoder(r, opts), nil
+	}
+}
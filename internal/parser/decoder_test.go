@@ -0,0 +1,65 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/roman-wb/price-service/internal/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectFormat(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		format      string
+		contentType string
+		feedName    string
+
+		want string
+	}{
+		{
+			name: "Explicit format",
+
+			format:      models.FormatNDJSON,
+			contentType: "application/json",
+			feedName:    "/price.csv",
+
+			want: models.FormatNDJSON,
+		},
+		{
+			name: "Content-Type with params",
+
+			contentType: "application/json; charset=utf-8",
+			feedName:    "/price.csv",
+
+			want: models.FormatJSON,
+		},
+		{
+			name: "Unknown Content-Type and extension",
+
+			contentType: "application/octet-stream",
+			feedName:    "/price.JSONL",
+
+			want: models.FormatNDJSON,
+		},
+		{
+			name: "Default",
+
+			contentType: "",
+			feedName:    "/price",
+
+			want: models.FormatCSV,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := detectFormat(tc.format, tc.contentType, tc.feedName)
+
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package parser
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// jsonDecoder reads array of items. Line of row is number of item, broken
+// json stops reading because the rest of array can't be found.
+type jsonDecoder struct {
+	decoder *json.Decoder
+	opts    jsonOptions
+	started bool
+	item    int
+}
+
+func newJSONDecoder(r io.Reader, opts jsonOptions) *jsonDecoder {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+
+	return &jsonDecoder{
+		decoder: decoder,
+		opts:    opts,
+	}
+}
+
+func (d *jsonDecoder) Decode() (row, error) {
+	if !d.started {
+		d.started = true
+		token, err := d.decoder.Token()
+		if err != nil {
+			return row{}, err
+		}
+		if token != json.Delim('[') {
+			return row{}, fmt.Errorf("json feed is not array")
+		}
+	}
+
+	if !d.decoder.More() {
+		return row{}, io.EOF
+	}
+
+	var item interface{}
+	err := d.decoder.Decode(&item)
+	if err != nil {
+		return row{}, err
+	}
+	d.item++
+
+	return d.opts.row(d.item, item)
+}
+
+// ndjsonDecoder reads one item per line, blank lines are skipped.
+type ndjsonDecoder struct {
+	reader *bufio.Reader
+	opts   jsonOptions
+	line   int
+}
+
+func newNDJSONDecoder(r io.Reader, opts jsonOptions) *ndjsonDecoder {
+	return &ndjsonDecoder{
+		reader: bufio.NewReader(r),
+		opts:   opts,
+	}
+}
+
+func (d *ndjsonDecoder) Decode() (row, error) {
+	for {
+		text, err := d.reader.ReadString('\n')
+		if text == "" && err != nil {
+			return row{}, err
+		}
+		d.line++
+
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		decoder := json.NewDecoder(strings.NewReader(text))
+		decoder.UseNumber()
+
+		var item interface{}
+		err = decoder.Decode(&item)
+		if err != nil {
+			return row{}, &rowError{line: d.line, reason: fmt.Sprintf("invalid json: %v", err)}
+		}
+
+		return d.opts.row(d.line, item)
+	}
+}
+
+func (o jsonOptions) row(line int, item interface{}) (row, error) {
+	name, _, err := jsonValue(item, o.namePath)
+	if err != nil {
+		return row{}, &rowError{line: line, reason: fmt.Sprintf("invalid name: %v", err)}
+	}
+
+	price, number, err := jsonValue(item, o.pricePath)
+	if err != nil {
+		return row{}, &rowError{line: line, reason: fmt.Sprintf("invalid price: %v", err)}
+	}
+
+	currency, _, err := jsonValue(item, o.currencyPath)
+	if err != nil {
+		return row{}, &rowError{line: line, reason: fmt.Sprintf("invalid currency: %v", err)}
+	}
+
+	return row{line: line, name: name, price: price, number: number, currency: currency}, nil
+}
+
+// jsonValue finds value by path and reports whether it is number.
+// Missing value is empty string, numbers are kept as written in feed.
+func jsonValue(item interface{}, path []string) (string, bool, error) {
+	value := item
+	for _, key := range path {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return "", false, nil
+		}
+		value = object[key]
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return "", false, nil
+	case string:
+		return v, false, nil
+	case json.Number:
+		return v.String(), true, nil
+	default:
+		return "", false, fmt.Errorf("%s is not string or number", strings.Join(path, "."))
+	}
+}
@@ -0,0 +1,111 @@
+package parser
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/roman-wb/price-service/internal/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONDecoderDecode(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		body   string
+		format models.JSONFormat
+
+		wantRows []row
+		wantErrs []error
+	}{
+		{
+			name: "Default paths",
+
+			body: `[{"name": "Product 1", "price": 1.5}, {"name": "Product 2", "price": "2"}, {"price": 3}]`,
+
+			wantRows: []row{
+				{line: 1, name: "Product 1", price: "1.5", number: true},
+				{line: 2, name: "Product 2", price: "2"},
+				{line: 3, name: "", price: "3", number: true},
+			},
+			wantErrs: []error{nil, nil, nil},
+		},
+		{
+			name: "Nested paths",
+
+			body:   `[{"product": {"title": "Product 1"}, "offer": {"price": 100}}, {"product": "Product 2", "offer": {"price": {"value": 1}}}]`,
+			format: models.JSONFormat{NamePath: "product.title", PricePath: "offer.price"},
+
+			wantRows: []row{
+				{line: 1, name: "Product 1", price: "100", number: true},
+				{},
+			},
+			wantErrs: []error{
+				nil,
+				&rowError{line: 2, reason: "invalid price: offer.price is not string or number"},
+			},
+		},
+		{
+			name: "Not array",
+
+			body: `{"name": "Product 1", "price": 1}`,
+
+			wantRows: []row{{}},
+			wantErrs: []error{errors.New("json feed is not array")},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, err := newJSONOptions(tc.format)
+			require.Nil(t, err)
+
+			decoder := newJSONDecoder(strings.NewReader(tc.body), opts)
+
+			for i := range tc.wantRows {
+				gotRow, gotErr := decoder.Decode()
+				require.Equal(t, tc.wantRows[i], gotRow)
+				require.Equal(t, tc.wantErrs[i], gotErr)
+			}
+			if tc.wantErrs[len(tc.wantErrs)-1] == nil {
+				_, gotErr := decoder.Decode()
+				require.Equal(t, io.EOF, gotErr)
+			}
+		})
+	}
+}
+
+func TestNDJSONDecoderDecode(t *testing.T) {
+	body := `{"name": "Product 1", "price": 1}
+
+{"name": "Product 2", "price": 
+{"name": "Product 3", "price": true}
+{"name": "Product 4", "price": 4}`
+
+	opts, err := newJSONOptions(models.JSONFormat{})
+	require.Nil(t, err)
+
+	decoder := newNDJSONDecoder(strings.NewReader(body), opts)
+
+	gotRow, gotErr := decoder.Decode()
+	require.Nil(t, gotErr)
+	require.Equal(t, row{line: 1, name: "Product 1", price: "1", number: true}, gotRow)
+
+	_, gotErr = decoder.Decode()
+	require.Equal(t, &rowError{line: 3, reason: "invalid json: unexpected EOF"}, gotErr)
+
+	_, gotErr = decoder.Decode()
+	require.Equal(t, &rowError{line: 4, reason: "invalid price: price is not string or number"}, gotErr)
+
+	gotRow, gotErr = decoder.Decode()
+	require.Nil(t, gotErr)
+	require.Equal(t, row{line: 5, name: "Product 4", price: "4", number: true}, gotRow)
+
+	_, gotErr = decoder.Decode()
+	require.Equal(t, io.EOF, gotErr)
+}
@@ -0,0 +1,47 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/roman-wb/price-service/internal/models"
+)
+
+// jsonOptions is validated models.JSONFormat with defaults.
+type jsonOptions struct {
+	namePath     []string
+	pricePath    []string
+	currencyPath []string
+}
+
+func newJSONOptions(format models.JSONFormat) (jsonOptions, error) {
+	var opts jsonOptions
+	var err error
+
+	opts.namePath, err = splitJSONPath(format.NamePath, "name")
+	if err != nil {
+		return opts, err
+	}
+
+	opts.pricePath, err = splitJSONPath(format.PricePath, "price")
+	if err != nil {
+		return opts, err
+	}
+
+	opts.currencyPath, err = splitJSONPath(format.CurrencyPath, "currency")
+	return opts, err
+}
+
+func splitJSONPath(path, def string) ([]string, error) {
+	if path == "" {
+		return []string{def}, nil
+	}
+
+	keys := strings.Split(path, ".")
+	for _, key := range keys {
+		if key == "" {
+			return nil, fmt.Errorf("invalid json path %q", path)
+		}
+	}
+	return keys, nil
+}
@@ -0,0 +1,51 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/roman-wb/price-service/internal/models"
+)
+
+// Limits cap fetched feeds, zero means no limit. MaxBytes caps downloaded
+// body, unpacked feed of compressed body and every unpacked file of
+// xlsx, MaxRows caps parsed and rejected rows, Timeout caps download of
+// body.
+type Limits struct {
+	MaxBytes int64
+	MaxRows  int
+	Timeout  time.Duration
+}
+
+// limitedReader fails with models.ErrLimitExceeded when reader has more
+// than max bytes, unlike io.LimitReader which stops silently.
+type limitedReader struct {
+	reader io.Reader
+	max    int64
+	left   int64
+}
+
+func newLimitedReader(reader io.Reader, max int64) io.Reader {
+	if max <= 0 {
+		return reader
+	}
+	return &limitedReader{reader: reader, max: max, left: max}
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	// One more byte tells that reader is over limit
+	if int64(len(p)) > r.left+1 {
+		p = p[:r.left+1]
+	}
+
+	n, err := r.reader.Read(p)
+	if int64(n) > r.left {
+		n = int(r.left)
+		r.left = 0
+		return n, fmt.Errorf("%w: feed is larger than %d bytes", models.ErrLimitExceeded, r.max)
+	}
+
+	r.left -= int64(n)
+	return n, err
+}
@@ -0,0 +1,144 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/roman-wb/price-service/internal/models"
+)
+
+// spaces are written instead of space grouping separator by spreadsheets
+// and locales.
+var spaces = []string{" ", "\u00a0", "\u202f"}
+
+// numberOptions is validated models.NumberFormat with defaults.
+type numberOptions struct {
+	decimal  string
+	grouping []string
+	symbols  []string
+}
+
+func newNumberOptions(format models.NumberFormat) (numberOptions, error) {
+	opts := numberOptions{
+		decimal: ".",
+		symbols: format.CurrencySymbols,
+	}
+
+	if format.DecimalSeparator != "" {
+		if !isSeparator(format.DecimalSeparator) {
+			return opts, fmt.Errorf("invalid decimal separator %q", format.DecimalSeparator)
+		}
+		opts.decimal = format.DecimalSeparator
+	}
+
+	if format.GroupingSeparator != "" {
+		if !isSeparator(format.GroupingSeparator) || format.GroupingSeparator == opts.decimal {
+			return opts, fmt.Errorf("invalid grouping separator %q", format.GroupingSeparator)
+		}
+		opts.grouping = []string{format.GroupingSeparator}
+		if unicode.IsSpace([]rune(format.GroupingSeparator)[0]) {
+			opts.grouping = spaces
+		}
+	}
+
+	for _, symbol := range format.CurrencySymbols {
+		if strings.TrimSpace(symbol) == "" || strings.ContainsAny(symbol, "0123456789+-") {
+			return opts, fmt.Errorf("invalid currency symbol %q", symbol)
+		}
+	}
+
+	return opts, nil
+}
+
+func isSeparator(s string) bool {
+	r, size := utf8.DecodeRuneInString(s)
+	return size == len(s) && r != utf8.RuneError && !unicode.IsDigit(r) && r != '-' && r != '+'
+}
+
+// normalize converts price to format of models.ParsePrice, like
+// "-€1 299,90" to "-1299.90". Groups of digits are checked, so "1,2"
+// is not taken as 12 by mistake.
+func (o numberOptions) normalize(raw string) (string, error) {
+	s := strings.TrimSpace(raw)
+
+	sign := ""
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		sign, s = s[:1], strings.TrimSpace(s[1:])
+	}
+
+	for _, symbol := range o.symbols {
+		if strings.HasPrefix(s, symbol) {
+			s = strings.TrimSpace(strings.TrimPrefix(s, symbol))
+			break
+		}
+		if strings.HasSuffix(s, symbol) {
+			s = strings.TrimSpace(strings.TrimSuffix(s, symbol))
+			break
+		}
+	}
+
+	if sign == "" && (strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+")) {
+		sign, s = s[:1], strings.TrimSpace(s[1:])
+	}
+
+	// default separators are checked by models.ParsePrice
+	if o.decimal == "." && len(o.grouping) == 0 {
+		return sign + s, nil
+	}
+
+	integer, fraction := s, ""
+	if i := strings.Index(s, o.decimal); i >= 0 {
+		integer, fraction = s[:i], s[i+len(o.decimal):]
+		if fraction == "" || !isDigits(fraction) {
+			return "", fmt.Errorf("invalid fraction %q", fraction)
+		}
+		fraction = "." + fraction
+	}
+
+	if integer == "" && fraction != "" {
+		integer = "0"
+	}
+	integer, err := o.ungroup(integer)
+	if err != nil {
+		return "", err
+	}
+
+	return sign + integer + fraction, nil
+}
+
+func (o numberOptions) ungroup(integer string) (string, error) {
+	groups := []string{integer}
+	if len(o.grouping) > 0 {
+		for _, sep := range o.grouping[1:] {
+			integer = strings.ReplaceAll(integer, sep, o.grouping[0])
+		}
+		groups = strings.Split(integer, o.grouping[0])
+	}
+
+	for i, group := range groups {
+		switch {
+		case !isDigits(group):
+			return "", fmt.Errorf("invalid digits %q", group)
+		case len(groups) > 1 && i == 0 && len(group) > 3:
+			return "", fmt.Errorf("invalid group %q", group)
+		case i > 0 && len(group) != 3:
+			return "", fmt.Errorf("invalid group %q", group)
+		}
+	}
+
+	return strings.Join(groups, ""), nil
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,166 @@
+package parser
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/roman-wb/price-service/internal/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNumberOptions(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		format models.NumberFormat
+
+		wantErr error
+	}{
+		{
+			name: "Defaults",
+
+			format: models.NumberFormat{},
+
+			wantErr: nil,
+		},
+		{
+			name: "Long decimal separator",
+
+			format: models.NumberFormat{DecimalSeparator: ",,"},
+
+			wantErr: errors.New(`invalid decimal separator ",,"`),
+		},
+		{
+			name: "Same separators",
+
+			format: models.NumberFormat{DecimalSeparator: ",", GroupingSeparator: ","},
+
+			wantErr: errors.New(`invalid grouping separator ","`),
+		},
+		{
+			name: "Digit in currency symbol",
+
+			format: models.NumberFormat{CurrencySymbols: []string{"$", "1$"}},
+
+			wantErr: errors.New(`invalid currency symbol "1$"`),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, gotErr := newNumberOptions(tc.format)
+
+			require.Equal(t, tc.wantErr, gotErr)
+		})
+	}
+}
+
+func TestNumberOptionsNormalize(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		format models.NumberFormat
+		raw    string
+
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Default format",
+
+			raw: " -1.5e3 ",
+
+			want: "-1.5e3",
+		},
+		{
+			name: "Default format with currency",
+
+			format: models.NumberFormat{CurrencySymbols: []string{"€", "EUR"}},
+			raw:    "€12.50",
+
+			want: "12.50",
+		},
+		{
+			name: "Space grouping and comma decimal",
+
+			format: models.NumberFormat{DecimalSeparator: ",", GroupingSeparator: " "},
+			raw:    "1 299,90",
+
+			want: "1299.90",
+		},
+		{
+			name: "No-break space grouping",
+
+			format: models.NumberFormat{DecimalSeparator: ",", GroupingSeparator: " "},
+			raw:    "1\u00a0299\u202f000,5",
+
+			want: "1299000.5",
+		},
+		{
+			name: "Comma grouping with currency suffix and sign",
+
+			format: models.NumberFormat{GroupingSeparator: ",", CurrencySymbols: []string{"USD"}},
+			raw:    "-1,299.90 USD",
+
+			want: "-1299.90",
+		},
+		{
+			name: "Sign after currency",
+
+			format: models.NumberFormat{DecimalSeparator: ",", CurrencySymbols: []string{"€"}},
+			raw:    "€ -0,5",
+
+			want: "-0.5",
+		},
+		{
+			name: "Fraction without integer",
+
+			format: models.NumberFormat{DecimalSeparator: ","},
+			raw:    ",5",
+
+			want: "0.5",
+		},
+		{
+			name: "Invalid group",
+
+			format: models.NumberFormat{GroupingSeparator: ","},
+			raw:    "1,29.90",
+
+			wantErr: errors.New(`invalid group "29"`),
+		},
+		{
+			name: "Wrong decimal separator",
+
+			format: models.NumberFormat{DecimalSeparator: ",", GroupingSeparator: " "},
+			raw:    "1299.90",
+
+			wantErr: errors.New(`invalid digits "1299.90"`),
+		},
+		{
+			name: "Invalid fraction",
+
+			format: models.NumberFormat{DecimalSeparator: ","},
+			raw:    "12,5,0",
+
+			wantErr: errors.New(`invalid fraction "5,0"`),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, err := newNumberOptions(tc.format)
+			require.Nil(t, err)
+
+			got, gotErr := opts.normalize(tc.raw)
+
+			require.Equal(t, tc.want, got)
+			require.Equal(t, tc.wantErr, gotErr)
+		})
+	}
+}
@@ -3,72 +3,188 @@
 package parser
 
 import (
-	"encoding/csv"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
-	"strconv"
-	"strings"
 
 	"github.com/roman-wb/price-service/internal/models"
 )
 
 type HttpClient interface {
-	Get(url string) (resp *http.Response, err error)
+	Do(req *http.Request) (*http.Response, error)
 }
 
+// Parser fetches feeds allowed by policy within limits. Addresses of
+// hosts are checked by httpClient, see NewHttpClient.
 type Parser struct {
-	httpClient HttpClient
+	httpClient   HttpClient
+	policy       URLPolicy
+	limits       Limits
+	contentTypes ContentTypes
 }
 
-func NewParser(httpClient HttpClient) *Parser {
+func NewParser(httpClient HttpClient, policy URLPolicy, limits Limits, contentTypes ContentTypes) *Parser {
 	return &Parser{
-		httpClient: httpClient,
+		httpClient:   httpClient,
+		policy:       policy,
+		limits:       limits,
+		contentTypes: contentTypes,
 	}
 }
 
-func (p *Parser) Fetch(rawurl string) ([]models.Price, error) {
-	_, err := url.ParseRequestURI(rawurl)
+// Validate checks feed without fetching it, errors are
+// models.FieldError with field of FetchRequest.
+func (p *Parser) Validate(feed models.Feed) error {
+	u, err := url.ParseRequestURI(feed.URL)
 	if err != nil {
-		return nil, err
+		return &models.FieldError{Field: "url", Err: err}
 	}
 
-	resp, err := p.httpClient.Get(rawurl)
+	err = p.policy.CheckURL(u)
 	if err != nil {
-		return nil, err
+		return &models.FieldError{Field: "url", Err: err}
 	}
-	defer resp.Body.Close()
 
-	return p.parse(resp.Body)
+	err = validateFormat(feed.Format)
+	if err != nil {
+		return &models.FieldError{Field: "format", Err: err}
+	}
+
+	_, err = newCSVOptions(feed.CSV)
+	if err != nil {
+		return &models.FieldError{Field: "csv", Err: err}
+	}
+
+	_, err = newJSONOptions(feed.JSON)
+	if err != nil {
+		return &models.FieldError{Field: "json", Err: err}
+	}
+
+	_, err = newXLSXOptions(feed.XLSX)
+	if err != nil {
+		return &models.FieldError{Field: "xlsx", Err: err}
+	}
+
+	_, err = newNumberOptions(feed.Number)
+	if err != nil {
+		return &models.FieldError{Field: "number_format", Err: err}
+	}
+
+	_, err = models.ParseCurrency(feed.Currency)
+	if err != nil {
+		return &models.FieldError{Field: "currency", Err: err}
+	}
+
+	if feed.MaxDeletedPercent < 0 || feed.MaxDeletedPercent > 100 {
+		return &models.FieldError{Field: "max_deleted_percent", Err: fmt.Errorf("max deleted percent %d isn't between 0 and 100", feed.MaxDeletedPercent)}
+	}
+
+	return nil
 }
 
-func (p *Parser) parse(body io.ReadCloser) ([]models.Price, error) {
-	reader := csv.NewReader(body)
-	reader.Comma = ';'
-	reader.FieldsPerRecord = 2
+// Fetch requests feed and returns reader of prices and state of fetched
+// feed. Reader must be closed. Request is conditional by previous state,
+// models.ErrNotModified is returned when feed isn't changed since then.
+// Body is downloaded before parsing, so its hash is compared first.
+// Download is stopped by deadline of ctx or Limits.Timeout. Responses
+// with non-2xx status fail with models.StatusError, responses of not
+// allowed content types fail before download.
+func (p *Parser) Fetch(ctx context.Context, feed models.Feed, previous models.FeedState) (models.PriceReader, models.FeedState, error) {
+	var state models.FeedState
 
-	var prices []models.Price
+	err := p.Validate(feed)
+	if err != nil {
+		return nil, state, err
+	}
 
-	for {
-		record, err := reader.Read()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			continue
-		}
+	number, err := newNumberOptions(feed.Number)
+	if err != nil {
+		return nil, state, err
+	}
 
-		name := strings.TrimSpace(record[0])
-		price, err := strconv.ParseFloat(record[1], 64)
-		if err != nil {
-			continue
-		}
+	currency, err := models.ParseCurrency(feed.Currency)
+	if err != nil {
+		return nil, state, err
+	}
 
-		prices = append(prices, models.Price{
-			Name:  name,
-			Price: price,
-		})
+	if p.limits.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.limits.Timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, feed.URL, nil)
+	if err != nil {
+		return nil, state, err
+	}
+	if previous.ETag != "" {
+		req.Header.Set("If-None-Match", previous.ETag)
+	}
+	if previous.LastModified != "" {
+		req.Header.Set("If-Modified-Since", previous.LastModified)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, state, err
+	}
+	if resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		return nil, state, models.ErrNotModified
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		resp.Body.Close()
+		return nil, state, &models.StatusError{StatusCode: resp.StatusCode, Status: resp.Status}
+	}
+	err = p.contentTypes.Check(resp.Header.Get("Content-Type"))
+	if err != nil {
+		resp.Body.Close()
+		return nil, state, err
+	}
+
+	hash := sha256.New()
+	file, err := newTempFile(io.TeeReader(newLimitedReader(resp.Body, p.limits.MaxBytes), hash), "price-*")
+	resp.Body.Close()
+	if err != nil {
+		return nil, state, err
+	}
+
+	state = models.FeedState{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		Hash:         hex.EncodeToString(hash.Sum(nil)),
+	}
+	if previous.Hash != "" && previous.Hash == state.Hash {
+		file.Close()
+		return nil, state, models.ErrNotModified
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	compression := detectCompression(feed, resp.Header)
+	if compression != "" && resp.Header.Get("Content-Encoding") == "" {
+		// Content-Type describes archive, not feed inside it
+		contentType = ""
+	}
+
+	body, name, err := decompress(file, compression, feedName(feed.URL), feed.ZipEntry)
+	if err != nil {
+		file.Close()
+		return nil, state, err
+	}
+	if compression != "" {
+		body = &compressedBody{Reader: newLimitedReader(body, p.limits.MaxBytes), closers: []io.Closer{body}}
+	}
+
+	format := detectFormat(feed.Format, contentType, name)
+	decoder, err := newDecoder(body, format, feed, p.limits.MaxBytes)
+	if err != nil {
+		body.Close()
+		return nil, state, err
 	}
 
-	return prices, nil
+	return newReader(body, decoder, number, currency, p.limits.MaxRows, feed.AllowEmpty), state, nil
 }
@@ -2,10 +2,19 @@ package parser
 
 import (
 	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/roman-wb/price-service/internal/models"
@@ -14,21 +23,26 @@ import (
 )
 
 func TestParserFetch(t *testing.T) {
+	workbook, err := ioutil.ReadFile("testdata/prices.xlsx")
+	require.Nil(t, err)
+
 	testCases := []struct {
 		name string
 
-		url string
+		feed         models.Feed
+		contentTypes ContentTypes
 
 		mockHttpResp *http.Response
 		mockHttpErr  error
 
-		wantData []models.Price
-		wantErr  error
+		wantData    []models.Price
+		wantRejects []models.Reject
+		wantErr     error
 	}{
 		{
 			name: "Empty URL",
 
-			url: "",
+			feed: models.Feed{URL: ""},
 
 			wantData: nil,
 			wantErr:  errors.New(`parse "": empty url`),
@@ -36,28 +50,82 @@ func TestParserFetch(t *testing.T) {
 		{
 			name: "Invalid URL",
 
-			url: "yandex.ru/price",
+			feed: models.Feed{URL: "yandex.ru/price"},
 
 			wantData: nil,
 			wantErr:  errors.New(`parse "yandex.ru/price": invalid URI for request`),
 		},
+		{
+			name: "Forbidden URL",
+
+			feed: models.Feed{URL: "http://169.254.169.254/latest/meta-data"},
+
+			wantData: nil,
+			wantErr:  errors.New(`forbidden url: private address 169.254.169.254`),
+		},
 		{
 			name: "Http request returns error",
 
-			url: "http://yandex.ru/price",
+			feed: models.Feed{URL: "http://yandex.ru/price"},
 
 			mockHttpErr: errors.New("http error..."),
 
 			wantData: nil,
 			wantErr:  errors.New(`http error...`),
 		},
+		{
+			name: "Http response status isn't 2xx",
+
+			feed: models.Feed{URL: "http://yandex.ru/price"},
+
+			mockHttpResp: &http.Response{
+				StatusCode: http.StatusNotFound,
+				Status:     "404 Not Found",
+				Header:     http.Header{"Content-Type": []string{"text/html"}},
+				Body:       ioutil.NopCloser(strings.NewReader("<html>Not Found</html>")),
+			},
+
+			wantData: nil,
+			wantErr:  &models.StatusError{StatusCode: http.StatusNotFound, Status: "404 Not Found"},
+		},
+		{
+			name: "Content type isn't allowed",
+
+			feed:         models.Feed{URL: "http://yandex.ru/price"},
+			contentTypes: ContentTypes{"text/csv", "application/json"},
+
+			mockHttpResp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"text/html; charset=utf-8"}},
+				Body:       ioutil.NopCloser(strings.NewReader("<html></html>")),
+			},
+
+			wantData: nil,
+			wantErr:  errors.New(`unsupported content type "text/html"`),
+		},
+		{
+			name: "Content type is allowed",
+
+			feed:         models.Feed{URL: "http://yandex.ru/price"},
+			contentTypes: ContentTypes{"text/*"},
+
+			mockHttpResp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"text/csv; charset=utf-8"}},
+				Body:       ioutil.NopCloser(strings.NewReader("Product 1;1")),
+			},
+
+			wantData: []models.Price{{Name: "Product 1", Price: models.MustParsePrice("1")}},
+			wantErr:  nil,
+		},
 		{
 			name: "Http request returns empty data",
 
-			url: "http://yandex.ru/price",
+			feed: models.Feed{URL: "http://yandex.ru/price", AllowEmpty: true},
 
 			mockHttpResp: &http.Response{
-				Body: ioutil.NopCloser(bytes.NewReader([]byte(``))),
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(``))),
 			},
 
 			wantData: nil,
@@ -66,9 +134,10 @@ func TestParserFetch(t *testing.T) {
 		{
 			name: "Parsed data",
 
-			url: "http://yandex.ru/price",
+			feed: models.Feed{URL: "http://yandex.ru/price"},
 
 			mockHttpResp: &http.Response{
+				StatusCode: http.StatusOK,
 				Body: ioutil.NopCloser(bytes.NewReader([]byte(`
 					Product 1;-1
 					Product 2;0
@@ -79,13 +148,281 @@ func TestParserFetch(t *testing.T) {
 			},
 
 			wantData: []models.Price{
-				{Name: "Product 1", Price: -1},
-				{Name: "Product 2", Price: 0},
-				{Name: "Product 3", Price: 0.99},
-				{Name: "Product 4", Price: 100.99},
+				{Name: "Product 1", Price: models.MustParsePrice("-1")},
+				{Name: "Product 2", Price: models.MustParsePrice("0")},
+				{Name: "Product 3", Price: models.MustParsePrice("0.99")},
+				{Name: "Product 4", Price: models.MustParsePrice("100.99")},
+			},
+			wantRejects: []models.Reject{
+				{Line: 5, Reason: `invalid price "error"`},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Parsed data with rejects",
+
+			feed: models.Feed{URL: "http://yandex.ru/price"},
+
+			mockHttpResp: &http.Response{
+				StatusCode: http.StatusOK,
+				Body: ioutil.NopCloser(bytes.NewReader([]byte("Product 1;1\n" +
+					"Product 2\n" +
+					"\n" +
+					"Product 3;1;2\n" +
+					";5\n" +
+					"\"Product\n4\";4\n" +
+					"Product \"5\";5\n" +
+					"Product 6;6"))),
+			},
+
+			wantData: []models.Price{
+				{Name: "Product 1", Price: models.MustParsePrice("1")},
+				{Name: "Product 3", Price: models.MustParsePrice("1")},
+				{Name: "Product\n4", Price: models.MustParsePrice("4")},
+				{Name: "Product 6", Price: models.MustParsePrice("6")},
+			},
+			wantRejects: []models.Reject{
+				{Line: 2, Reason: "wrong number of fields"},
+				{Line: 5, Reason: "empty name"},
+				{Line: 8, Reason: `bare " in non-quoted-field`},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Invalid CSV format",
+
+			feed: models.Feed{URL: "http://yandex.ru/price", CSV: models.CSVFormat{Delimiter: ",", Quote: ","}},
+
+			wantData: nil,
+			wantErr:  errors.New(`csv quote and delimiter are the same ","`),
+		},
+		{
+			name: "Parsed data with CSV format",
+
+			feed: models.Feed{URL: "http://yandex.ru/price", CSV: models.CSVFormat{
+				Delimiter:   "\t",
+				Quote:       "'",
+				Header:      true,
+				NameColumn:  3,
+				PriceColumn: 2,
+			}},
+
+			mockHttpResp: &http.Response{
+				StatusCode: http.StatusOK,
+				Body: ioutil.NopCloser(bytes.NewReader([]byte("sku\tprice\tname\n" +
+					"1\t1.5\tProduct 1\n" +
+					"2\t2\t'Product\t''2'''\textra\n" +
+					"3\t3\t\"Product 3\"\n" +
+					"4\t4\n"))),
+			},
+
+			wantData: []models.Price{
+				{Name: "Product 1", Price: models.MustParsePrice("1.5")},
+				{Name: "Product\t'2'", Price: models.MustParsePrice("2")},
+				{Name: `"Product 3"`, Price: models.MustParsePrice("3")},
+			},
+			wantRejects: []models.Reject{
+				{Line: 5, Reason: "wrong number of fields"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Unknown format",
+
+			feed: models.Feed{URL: "http://yandex.ru/price", Format: "xml"},
+
+			wantData: nil,
+			wantErr:  errors.New(`unknown feed format "xml"`),
+		},
+		{
+			name: "Parsed json data by Content-Type",
+
+			feed: models.Feed{URL: "http://yandex.ru/price", JSON: models.JSONFormat{NamePath: "title"}},
+
+			mockHttpResp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body: ioutil.NopCloser(bytes.NewReader([]byte(`[
+					{"title": "Product 1", "price": 1},
+					{"title": "Product 2", "price": "error"},
+					{"title": "Product 3", "price": "3.5"}
+				]`))),
+			},
+
+			wantData: []models.Price{
+				{Name: "Product 1", Price: models.MustParsePrice("1")},
+				{Name: "Product 3", Price: models.MustParsePrice("3.5")},
+			},
+			wantRejects: []models.Reject{
+				{Line: 2, Reason: `invalid price "error"`},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Parsed ndjson data by extension",
+
+			feed: models.Feed{URL: "http://yandex.ru/price.ndjson"},
+
+			mockHttpResp: &http.Response{
+				StatusCode: http.StatusOK,
+				Body: ioutil.NopCloser(bytes.NewReader([]byte("{\"name\": \"Product 1\", \"price\": 1}\n" +
+					"{\"name\": \"\", \"price\": 2}\n"))),
+			},
+
+			wantData: []models.Price{
+				{Name: "Product 1", Price: models.MustParsePrice("1")},
+			},
+			wantRejects: []models.Reject{
+				{Line: 2, Reason: "empty name"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Parsed xlsx data by extension",
+
+			feed: models.Feed{URL: "http://yandex.ru/price.xlsx", XLSX: models.XLSXFormat{
+				Sheet:       "Prices",
+				Header:      true,
+				NameColumn:  2,
+				PriceColumn: 3,
+			}},
+
+			mockHttpResp: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(workbook)),
+			},
+
+			wantData: []models.Price{
+				{Name: "Product 1", Price: models.MustParsePrice("10.5")},
+				{Name: "Product 2", Price: models.MustParsePrice("20")},
+				{Name: "Product 6", Price: models.MustParsePrice("1100")},
+			},
+			wantRejects: []models.Reject{
+				{Line: 5, Reason: `invalid price "error"`},
+				{Line: 6, Reason: "empty name"},
+				{Line: 8, Reason: "cell C8 has error #N/A"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Parsed gzip data by extension",
+
+			feed: models.Feed{URL: "http://yandex.ru/price.ndjson.gz"},
+
+			mockHttpResp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/gzip"}},
+				Body:       ioutil.NopCloser(bytes.NewReader(gzipData(t, `{"name": "Product 1", "price": 1}`))),
+			},
+
+			wantData: []models.Price{
+				{Name: "Product 1", Price: models.MustParsePrice("1")},
+			},
+			wantRejects: nil,
+			wantErr:     nil,
+		},
+		{
+			name: "Parsed zip data by Content-Type",
+
+			feed: models.Feed{URL: "http://yandex.ru/price", ZipEntry: "price.csv"},
+
+			mockHttpResp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/zip"}},
+				Body:       ioutil.NopCloser(bytes.NewReader(zipData(t, "price.json", "[]", "price.csv", "Product 1;1"))),
+			},
+
+			wantData: []models.Price{
+				{Name: "Product 1", Price: models.MustParsePrice("1")},
+			},
+			wantRejects: nil,
+			wantErr:     nil,
+		},
+		{
+			name: "Parsed data with number format",
+
+			feed: models.Feed{URL: "http://yandex.ru/price", Number: models.NumberFormat{
+				DecimalSeparator:  ",",
+				GroupingSeparator: " ",
+				CurrencySymbols:   []string{"€"},
+			}},
+
+			mockHttpResp: &http.Response{
+				StatusCode: http.StatusOK,
+				Body: ioutil.NopCloser(bytes.NewReader([]byte("Product 1;1 299,90\n" +
+					"Product 2;€12,50\n" +
+					"Product 3;12.50\n" +
+					"Product 4;$12\n"))),
+			},
+
+			wantData: []models.Price{
+				{Name: "Product 1", Price: models.MustParsePrice("1299.90")},
+				{Name: "Product 2", Price: models.MustParsePrice("12.50")},
+			},
+			wantRejects: []models.Reject{
+				{Line: 3, Reason: `invalid price "12.50"`},
+				{Line: 4, Reason: `invalid price "$12"`},
 			},
 			wantErr: nil,
 		},
+		{
+			name: "Invalid number format",
+
+			feed: models.Feed{URL: "http://yandex.ru/price", Number: models.NumberFormat{DecimalSeparator: "dot"}},
+
+			wantData: nil,
+			wantErr:  errors.New(`invalid decimal separator "dot"`),
+		},
+		{
+			name: "Parsed data with currency",
+
+			feed: models.Feed{URL: "http://yandex.ru/price", Currency: "usd", CSV: models.CSVFormat{CurrencyColumn: 3}},
+
+			mockHttpResp: &http.Response{
+				StatusCode: http.StatusOK,
+				Body: ioutil.NopCloser(bytes.NewReader([]byte("Product 1;1;eur\n" +
+					"Product 2;2;\n" +
+					"Product 3;3;euro\n"))),
+			},
+
+			wantData: []models.Price{
+				{Name: "Product 1", Price: models.MustParsePrice("1"), Currency: "EUR"},
+				{Name: "Product 2", Price: models.MustParsePrice("2"), Currency: "USD"},
+			},
+			wantRejects: []models.Reject{
+				{Line: 3, Reason: `invalid currency "euro"`},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Invalid currency",
+
+			feed: models.Feed{URL: "http://yandex.ru/price", Currency: "dollar"},
+
+			wantData: nil,
+			wantErr:  errors.New(`invalid currency "dollar"`),
+		},
+		{
+			name: "Invalid max deleted percent",
+
+			feed: models.Feed{URL: "http://yandex.ru/price", Snapshot: true, MaxDeletedPercent: 101},
+
+			wantData: nil,
+			wantErr:  errors.New(`max deleted percent 101 isn't between 0 and 100`),
+		},
+		{
+			name: "Invalid zip",
+
+			feed: models.Feed{URL: "http://yandex.ru/price.zip"},
+
+			mockHttpResp: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte("Product 1;1"))),
+			},
+
+			wantData: nil,
+			wantErr:  errors.New("invalid zip: zip: not a valid zip file"),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -101,18 +438,348 @@ func TestParserFetch(t *testing.T) {
 				mockHttpClient = mocks.NewMockHttpClient(ctrl)
 				mockHttpClient.
 					EXPECT().
-					Get(tc.url).
+					Do(requestTo(tc.feed.URL)).
 					Return(tc.mockHttpResp, tc.mockHttpErr)
 			}
 
-			parser := NewParser(mockHttpClient)
+			parser := NewParser(mockHttpClient, URLPolicy{}, Limits{}, tc.contentTypes)
+
+			gotReader, _, gotErr := parser.Fetch(context.Background(), tc.feed, models.FeedState{})
+			if tc.wantErr != nil {
+				require.Nil(t, gotReader)
+				require.Equal(t, tc.wantErr.Error(), gotErr.Error())
+				return
+			}
+			require.Nil(t, gotErr)
 
-			gotData, gotErr := parser.Fetch(tc.url)
+			gotData, gotStats := readAll(t, gotReader)
 
 			require.Equal(t, tc.wantData, gotData)
+			require.Equal(t, tc.wantRejects, gotStats.Rejects)
+			require.Equal(t, len(tc.wantData), gotStats.Parsed)
+			require.Equal(t, len(tc.wantRejects), gotStats.Rejected)
+		})
+	}
+}
+
+func TestParserFetchConditional(t *testing.T) {
+	body := "Product 1;1"
+	hash := sha256.Sum256([]byte(body))
+
+	testCases := []struct {
+		name string
+
+		previous models.FeedState
+
+		mockHttpResp *http.Response
+
+		wantHeader http.Header
+		wantState  models.FeedState
+		wantErr    error
+	}{
+		{
+			name: "Unconditional request",
+
+			previous: models.FeedState{},
+
+			mockHttpResp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Etag": []string{`"v1"`}, "Last-Modified": []string{"Wed, 28 Jul 2021 10:00:00 GMT"}},
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+			},
+
+			wantHeader: http.Header{},
+			wantState: models.FeedState{
+				ETag:         `"v1"`,
+				LastModified: "Wed, 28 Jul 2021 10:00:00 GMT",
+				Hash:         hex.EncodeToString(hash[:]),
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Not modified response",
+
+			previous: models.FeedState{ETag: `"v1"`, LastModified: "Wed, 28 Jul 2021 10:00:00 GMT"},
+
+			mockHttpResp: &http.Response{
+				StatusCode: http.StatusNotModified,
+				Body:       ioutil.NopCloser(strings.NewReader("")),
+			},
+
+			wantHeader: http.Header{
+				"If-None-Match":     []string{`"v1"`},
+				"If-Modified-Since": []string{"Wed, 28 Jul 2021 10:00:00 GMT"},
+			},
+			wantErr: models.ErrNotModified,
+		},
+		{
+			name: "Same hash",
+
+			previous: models.FeedState{Hash: hex.EncodeToString(hash[:])},
+
+			mockHttpResp: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+			},
+
+			wantHeader: http.Header{},
+			wantErr:    models.ErrNotModified,
+		},
+		{
+			name: "Changed hash",
+
+			previous: models.FeedState{Hash: "changed"},
+
+			mockHttpResp: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+			},
+
+			wantHeader: http.Header{},
+			wantState:  models.FeedState{Hash: hex.EncodeToString(hash[:])},
+			wantErr:    nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mocks.NewMockHttpClient(ctrl)
+			mockHttpClient.
+				EXPECT().
+				Do(requestTo("http://yandex.ru/price")).
+				DoAndReturn(func(req *http.Request) (*http.Response, error) {
+					require.Equal(t, tc.wantHeader, req.Header)
+					return tc.mockHttpResp, nil
+				})
+
+			parser := NewParser(mockHttpClient, URLPolicy{}, Limits{}, nil)
+
+			gotReader, gotState, gotErr := parser.Fetch(context.Background(), models.Feed{URL: "http://yandex.ru/price"}, tc.previous)
+			require.Equal(t, tc.wantErr, gotErr)
 			if tc.wantErr != nil {
-				require.Equal(t, tc.wantErr.Error(), gotErr.Error())
+				require.Nil(t, gotReader)
+				return
 			}
+			require.Equal(t, tc.wantState, gotState)
+
+			gotData, _ := readAll(t, gotReader)
+			require.Equal(t, []models.Price{{Name: "Product 1", Price: models.MustParsePrice("1")}}, gotData)
 		})
 	}
 }
+
+func TestParserFetchLimits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/price.csv.gz":
+			writer := gzip.NewWriter(w)
+			fmt.Fprint(writer, strings.Repeat("Product 1;1\n", 100))
+			writer.Close()
+		case "/slow.csv":
+			fmt.Fprint(w, "Product 1;1\n")
+			w.(http.Flusher).Flush()
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		default:
+			fmt.Fprint(w, "Product 1;1\nProduct 2;2\nProduct 3;3\n")
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	testCases := []struct {
+		name string
+
+		limits  Limits
+		timeout time.Duration
+		url     string
+
+		wantData    []models.Price
+		wantErr     error
+		wantErrText string
+		wantReadErr string
+	}{
+		{
+			name: "Feed within limits",
+
+			limits: Limits{MaxBytes: 100, MaxRows: 3, Timeout: time.Second},
+			url:    server.URL + "/price.csv",
+
+			wantData: []models.Price{
+				{Name: "Product 1", Price: models.MustParsePrice("1")},
+				{Name: "Product 2", Price: models.MustParsePrice("2")},
+				{Name: "Product 3", Price: models.MustParsePrice("3")},
+			},
+		},
+		{
+			name: "Body is larger than max bytes",
+
+			limits: Limits{MaxBytes: 20},
+			url:    server.URL + "/price.csv",
+
+			wantErr:     models.ErrLimitExceeded,
+			wantErrText: "limit exceeded: feed is larger than 20 bytes",
+		},
+		{
+			name: "Unpacked body is larger than max bytes",
+
+			limits: Limits{MaxBytes: 100},
+			url:    server.URL + "/price.csv.gz",
+
+			wantReadErr: "limit exceeded: feed is larger than 100 bytes",
+		},
+		{
+			name: "Feed has more than max rows",
+
+			limits: Limits{MaxRows: 2},
+			url:    server.URL + "/price.csv",
+
+			wantReadErr: "limit exceeded: feed has more than 2 rows",
+		},
+		{
+			name: "Download is longer than timeout",
+
+			limits: Limits{Timeout: 50 * time.Millisecond},
+			url:    server.URL + "/slow.csv",
+
+			wantErr:     context.DeadlineExceeded,
+			wantErrText: "context deadline exceeded",
+		},
+		{
+			name: "Download is longer than deadline of caller",
+
+			timeout: 50 * time.Millisecond,
+			url:     server.URL + "/slow.csv",
+
+			wantErr:     context.DeadlineExceeded,
+			wantErrText: "context deadline exceeded",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tc.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tc.timeout)
+				defer cancel()
+			}
+
+			parser := NewParser(NewHttpClient(URLPolicy{AllowPrivate: true}), URLPolicy{AllowPrivate: true}, tc.limits, nil)
+
+			gotReader, _, gotErr := parser.Fetch(ctx, models.Feed{URL: tc.url}, models.FeedState{})
+			if tc.wantErr != nil {
+				require.Nil(t, gotReader)
+				require.True(t, errors.Is(gotErr, tc.wantErr), gotErr)
+				require.Contains(t, gotErr.Error(), tc.wantErrText)
+				return
+			}
+			require.Nil(t, gotErr)
+			defer gotReader.Close()
+
+			var gotData []models.Price
+			for {
+				price, err := gotReader.Read()
+				if err == io.EOF {
+					break
+				}
+				if tc.wantReadErr != "" && err != nil {
+					require.True(t, errors.Is(err, models.ErrLimitExceeded))
+					require.Equal(t, tc.wantReadErr, err.Error())
+					return
+				}
+				require.Nil(t, err)
+				gotData = append(gotData, price)
+			}
+
+			require.Empty(t, tc.wantReadErr)
+			require.Equal(t, tc.wantData, gotData)
+		})
+	}
+}
+
+func TestParserFetchCancel(t *testing.T) {
+	started := make(chan struct{})
+	aborted := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "Product 1;1\n")
+		w.(http.Flusher).Flush()
+		close(started)
+
+		select {
+		case <-r.Context().Done():
+			close(aborted)
+		case <-time.After(time.Second):
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	parser := NewParser(NewHttpClient(URLPolicy{AllowPrivate: true}), URLPolicy{AllowPrivate: true}, Limits{}, nil)
+
+	gotReader, _, gotErr := parser.Fetch(ctx, models.Feed{URL: server.URL}, models.FeedState{})
+
+	require.Nil(t, gotReader)
+	require.True(t, errors.Is(gotErr, context.Canceled), gotErr)
+
+	select {
+	case <-aborted:
+	case <-time.After(time.Second):
+		t.Fatal("download wasn't aborted")
+	}
+}
+
+// requestTo matches GET request of url.
+func requestTo(url string) gomock.Matcher {
+	return gomock.GotFormatterAdapter(
+		gomock.GotFormatterFunc(func(got interface{}) string {
+			if req, ok := got.(*http.Request); ok {
+				return req.Method + " " + req.URL.String()
+			}
+			return fmt.Sprint(got)
+		}),
+		requestMatcher(url),
+	)
+}
+
+type requestMatcher string
+
+func (m requestMatcher) Matches(x interface{}) bool {
+	req, ok := x.(*http.Request)
+	return ok && req.Method == http.MethodGet && req.URL.String() == string(m)
+}
+
+func (m requestMatcher) String() string {
+	return "GET " + string(m)
+}
+
+func readAll(t *testing.T, reader models.PriceReader) ([]models.Price, models.ImportStats) {
+	defer reader.Close()
+
+	var prices []models.Price
+	for {
+		price, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		require.Nil(t, err)
+		prices = append(prices, price)
+	}
+
+	return prices, reader.Stats()
+}
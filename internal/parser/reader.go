@@ -0,0 +1,113 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/roman-wb/price-service/internal/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MaxRejects caps rejected rows kept by Reader.
+const MaxRejects = 100
+
+// Reader parses prices one by one, so memory doesn't depend on body size.
+// Currency is set to prices without currency in row. Rows over maxRows
+// fail with models.ErrLimitExceeded, zero maxRows means no limit. Feed
+// without valid prices fails with models.ErrEmptyFeed unless allowEmpty.
+type Reader struct {
+	body       io.ReadCloser
+	decoder    decoder
+	number     numberOptions
+	currency   string
+	maxRows    int
+	allowEmpty bool
+	stats      models.ImportStats
+}
+
+func newReader(body io.ReadCloser, decoder decoder, number numberOptions, currency string, maxRows int, allowEmpty bool) *Reader {
+	return &Reader{
+		body:       body,
+		decoder:    decoder,
+		number:     number,
+		currency:   currency,
+		maxRows:    maxRows,
+		allowEmpty: allowEmpty,
+	}
+}
+
+// Read returns next valid price. Invalid rows are skipped and counted
+// as rejected.
+func (r *Reader) Read() (models.Price, error) {
+	for {
+		row, err := r.decoder.Decode()
+		rowErr, isRowErr := err.(*rowError)
+		if (err == nil || isRowErr) && r.maxRows > 0 && r.stats.Parsed+r.stats.Rejected >= r.maxRows {
+			return models.Price{}, fmt.Errorf("%w: feed has more than %d rows", models.ErrLimitExceeded, r.maxRows)
+		}
+		if isRowErr {
+			r.reject(rowErr.line, rowErr.reason)
+			continue
+		}
+		if err == io.EOF && r.stats.Parsed == 0 && !r.allowEmpty {
+			return models.Price{}, fmt.Errorf("%w: %d rows rejected", models.ErrEmptyFeed, r.stats.Rejected)
+		}
+		if err != nil {
+			return models.Price{}, err
+		}
+
+		name := strings.TrimSpace(row.name)
+		if name == "" {
+			r.reject(row.line, "empty name")
+			continue
+		}
+
+		rawPrice := row.price
+		if !row.number {
+			rawPrice, err = r.number.normalize(row.price)
+		}
+		var price primitive.Decimal128
+		if err == nil {
+			price, err = models.ParsePrice(strings.TrimSpace(rawPrice))
+		}
+		if err != nil {
+			r.reject(row.line, fmt.Sprintf("invalid price %q", row.price))
+			continue
+		}
+
+		currency := r.currency
+		if strings.TrimSpace(row.currency) != "" {
+			currency, err = models.ParseCurrency(row.currency)
+			if err != nil {
+				r.reject(row.line, err.Error())
+				continue
+			}
+		}
+
+		r.stats.Parsed++
+		return models.Price{
+			Name:     name,
+			Price:    price,
+			Currency: currency,
+		}, nil
+	}
+}
+
+func (r *Reader) Stats() models.ImportStats {
+	return r.stats
+}
+
+func (r *Reader) Close() error {
+	if closer, ok := r.decoder.(io.Closer); ok {
+		closer.Close()
+	}
+	return r.body.Close()
+}
+
+func (r *Reader) reject(line int, reason string) {
+	r.stats.Rejected++
+	if len(r.stats.Rejects) < MaxRejects {
+		r.stats.Rejects = append(r.stats.Rejects, models.Reject{Line: line, Reason: reason})
+	}
+}
@@ -0,0 +1,90 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/roman-wb/price-service/internal/models"
+	"github.com/stretchr/testify/require"
+)
+
+type errReader struct {
+	err error
+}
+
+func (r *errReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
+
+func TestReaderRead(t *testing.T) {
+	t.Run("Caps rejects", func(t *testing.T) {
+		var body strings.Builder
+		for i := 0; i < MaxRejects+10; i++ {
+			fmt.Fprintf(&body, "Product %d\n", i)
+		}
+		body.WriteString("Product;1")
+
+		reader := newReader(ioutil.NopCloser(nil), newCSVDecoder(strings.NewReader(body.String()), defaultCSVOptions(t)), numberOptions{decimal: "."}, "", 0, false)
+
+		gotData, gotStats := readAll(t, reader)
+
+		require.Equal(t, []models.Price{{Name: "Product", Price: models.MustParsePrice("1")}}, gotData)
+		require.Equal(t, 1, gotStats.Parsed)
+		require.Equal(t, MaxRejects+10, gotStats.Rejected)
+		require.Equal(t, MaxRejects, len(gotStats.Rejects))
+		require.Equal(t, models.Reject{Line: 1, Reason: "wrong number of fields"}, gotStats.Rejects[0])
+	})
+
+	t.Run("Caps rows", func(t *testing.T) {
+		body := "Product 1;1\nProduct 2\nProduct 3;3\n"
+		reader := newReader(ioutil.NopCloser(nil), newCSVDecoder(strings.NewReader(body), defaultCSVOptions(t)), numberOptions{decimal: "."}, "", 2, false)
+
+		_, err := reader.Read()
+		require.Nil(t, err)
+
+		_, err = reader.Read()
+		require.True(t, errors.Is(err, models.ErrLimitExceeded))
+		require.Equal(t, "limit exceeded: feed has more than 2 rows", err.Error())
+		require.Equal(t, 1, reader.Stats().Parsed)
+		require.Equal(t, 1, reader.Stats().Rejected)
+	})
+
+	t.Run("Refuses empty feed", func(t *testing.T) {
+		body := "Product 1\nProduct 2;price\n"
+		reader := newReader(ioutil.NopCloser(nil), newCSVDecoder(strings.NewReader(body), defaultCSVOptions(t)), numberOptions{decimal: "."}, "", 0, false)
+
+		_, err := reader.Read()
+		require.True(t, errors.Is(err, models.ErrEmptyFeed))
+		require.Equal(t, "feed has no prices: 2 rows rejected", err.Error())
+		require.Equal(t, 2, reader.Stats().Rejected)
+	})
+
+	t.Run("Allows empty feed", func(t *testing.T) {
+		reader := newReader(ioutil.NopCloser(nil), newCSVDecoder(strings.NewReader(""), defaultCSVOptions(t)), numberOptions{decimal: "."}, "", 0, true)
+
+		_, err := reader.Read()
+		require.Equal(t, io.EOF, err)
+	})
+
+	t.Run("Returns body error", func(t *testing.T) {
+		body := io.MultiReader(strings.NewReader("Product;1\n"), &errReader{err: errors.New("connection reset")})
+		reader := newReader(ioutil.NopCloser(nil), newCSVDecoder(body, defaultCSVOptions(t)), numberOptions{decimal: "."}, "", 0, false)
+
+		gotPrice, gotErr := reader.Read()
+		require.Nil(t, gotErr)
+		require.Equal(t, models.Price{Name: "Product", Price: models.MustParsePrice("1")}, gotPrice)
+
+		_, gotErr = reader.Read()
+		require.Equal(t, errors.New("connection reset"), gotErr)
+	})
+}
+
+func defaultCSVOptions(t *testing.T) csvOptions {
+	opts, err := newCSVOptions(models.CSVFormat{})
+	require.Nil(t, err)
+	return opts
+}
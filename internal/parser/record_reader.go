@@ -0,0 +1,136 @@
+package parser
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MaxRecordLines caps lines of a record with quoted multi-line fields.
+const MaxRecordLines = 100
+
+// errRecordTooLong rejects the first line of a record over MaxRecordLines,
+// the rest of its lines are read again as new records.
+var errRecordTooLong = fmt.Errorf("quoted field isn't closed in %d lines", MaxRecordLines)
+
+// recordReader splits csv input into records and keeps the line number
+// every record starts on. Each record is parsed by its own csv.Reader, so
+// a broken record never swallows the rest of the input.
+type recordReader struct {
+	reader  *bufio.Reader
+	opts    csvOptions
+	line    int
+	pending []string
+}
+
+func newRecordReader(r io.Reader, opts csvOptions) *recordReader {
+	return &recordReader{
+		reader: bufio.NewReader(r),
+		opts:   opts,
+	}
+}
+
+// Read returns the next record with its line number. Blank lines are
+// skipped. Malformed records return the line number and *csv.ParseError,
+// any other error comes from the underlying reader.
+func (r *recordReader) Read() (int, []string, error) {
+	line, text, err := r.readText()
+	if err != nil {
+		return line, nil, err
+	}
+
+	// csv.Reader knows only '"', so other quote is swapped with it and
+	// swapped back in fields.
+	var swap *strings.Replacer
+	if r.opts.quote != '"' {
+		swap = strings.NewReplacer(string(r.opts.quote), `"`, `"`, string(r.opts.quote))
+		text = swap.Replace(text)
+	}
+
+	reader := csv.NewReader(strings.NewReader(text))
+	reader.Comma = r.opts.comma
+	reader.FieldsPerRecord = -1
+
+	record, err := reader.Read()
+	if swap != nil {
+		for i := range record {
+			record[i] = swap.Replace(record[i])
+		}
+	}
+	return line, record, err
+}
+
+// readText returns lines of the next record. Quote state is kept per line,
+// so every line is scanned once. Record over MaxRecordLines is rejected by
+// its first line and the other lines are read again.
+func (r *recordReader) readText() (int, string, error) {
+	var line int
+	var lines []string
+	state := quoteState{fieldStart: true}
+
+	for {
+		s, err := r.readLine()
+		if s == "" && err != nil {
+			if len(lines) > 0 {
+				return line, strings.Join(lines, ""), nil
+			}
+			return 0, "", err
+		}
+		r.line++
+
+		if len(lines) == 0 {
+			if strings.TrimSpace(s) == "" {
+				continue
+			}
+			line = r.line
+		}
+		lines = append(lines, s)
+
+		state.scan(s, r.opts)
+		if err != nil || !state.quoted {
+			return line, strings.Join(lines, ""), nil
+		}
+
+		if len(lines) == MaxRecordLines {
+			r.pending = append(lines[1:], r.pending...)
+			r.line = line
+			return line, "", &csv.ParseError{StartLine: line, Line: line, Err: errRecordTooLong}
+		}
+	}
+}
+
+// readLine returns the next pending line or the next line of input.
+func (r *recordReader) readLine() (string, error) {
+	if len(r.pending) > 0 {
+		s := r.pending[0]
+		r.pending = r.pending[1:]
+		return s, nil
+	}
+	return r.reader.ReadString('\n')
+}
+
+// quoteState tells whether scanned text ends inside a quoted field, that
+// is the record continues on the next line.
+type quoteState struct {
+	quoted     bool
+	fieldStart bool
+}
+
+func (q *quoteState) scan(line string, opts csvOptions) {
+	runes := []rune(line)
+	for i := 0; i < len(runes); i++ {
+		switch {
+		case q.quoted && runes[i] == opts.quote:
+			if i+1 < len(runes) && runes[i+1] == opts.quote {
+				i++
+				continue
+			}
+			q.quoted = false
+		case !q.quoted && runes[i] == opts.quote && q.fieldStart:
+			q.quoted = true
+		}
+		q.fieldStart = !q.quoted && runes[i] == opts.comma
+	}
+}
@@ -0,0 +1,49 @@
+package parser
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/roman-wb/price-service/internal/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordReaderUnclosedQuote(t *testing.T) {
+	var body strings.Builder
+	body.WriteString("\"Product 0;0\n")
+	for i := 1; i <= 2*MaxRecordLines; i++ {
+		fmt.Fprintf(&body, "Product %d;%d\n", i, i)
+	}
+
+	reader := newReader(ioutil.NopCloser(nil), newCSVDecoder(strings.NewReader(body.String()), defaultCSVOptions(t)), numberOptions{decimal: "."}, "", 0, false)
+
+	gotData, gotStats := readAll(t, reader)
+
+	require.Equal(t, 2*MaxRecordLines, len(gotData))
+	require.Equal(t, models.Price{Name: "Product 1", Price: models.MustParsePrice("1")}, gotData[0])
+	require.Equal(t, []models.Reject{
+		{Line: 1, Reason: "quoted field isn't closed in 100 lines"},
+	}, gotStats.Rejects)
+}
+
+func TestRecordReaderMultiLine(t *testing.T) {
+	body := "\"Product\n1\";1\n\n\"Product \"\"2\"\"\";2\n'\"Product 3;3"
+
+	records := newRecordReader(strings.NewReader(body), defaultCSVOptions(t))
+
+	line, record, err := records.Read()
+	require.Nil(t, err)
+	require.Equal(t, 1, line)
+	require.Equal(t, []string{"Product\n1", "1"}, record)
+
+	line, record, err = records.Read()
+	require.Nil(t, err)
+	require.Equal(t, 4, line)
+	require.Equal(t, []string{`Product "2"`, "2"}, record)
+
+	line, _, err = records.Read()
+	require.NotNil(t, err)
+	require.Equal(t, 5, line)
+}
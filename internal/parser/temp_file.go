@@ -0,0 +1,41 @@
+package parser
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+)
+
+// tempFile keeps body which is read with random access, like zip
+// archives. File is read from the start and removed on Close.
+type tempFile struct {
+	*os.File
+	size int64
+}
+
+func newTempFile(r io.Reader, pattern string) (*tempFile, error) {
+	file, err := ioutil.TempFile("", pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &tempFile{File: file}
+	f.size, err = io.Copy(file, r)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (f *tempFile) Close() error {
+	f.File.Close()
+	return os.Remove(f.Name())
+}
@@ -0,0 +1,194 @@
+package parser
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/roman-wb/price-service/internal/models"
+)
+
+// MaxRedirects caps redirects followed by client of URLPolicy.
+const MaxRedirects = 10
+
+// DefaultSchemes are allowed when URLPolicy has no schemes.
+var DefaultSchemes = []string{"http", "https"}
+
+// privateNets are blocked unless URLPolicy.AllowPrivate is set. Loopback,
+// link-local, multicast and unspecified addresses are checked by net.IP.
+var privateNets = mustParseNets(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"172.16.0.0/12",
+	"192.0.0.0/24",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"240.0.0.0/4",
+	"fc00::/7",
+)
+
+// URLPolicy restricts URLs fetched by Parser. Hosts are domains, which
+// match their subdomains too, or IPs and CIDRs. Non-empty AllowHosts
+// allows only listed hosts, DenyHosts wins over AllowHosts. Private
+// addresses are blocked unless AllowPrivate is set or they are listed in
+// AllowHosts.
+type URLPolicy struct {
+	Schemes      []string
+	AllowHosts   []string
+	DenyHosts    []string
+	AllowPrivate bool
+}
+
+// CheckURL checks scheme and host of u. Addresses of domains are checked
+// on dial by client of NewHttpClient.
+func (p URLPolicy) CheckURL(u *url.URL) error {
+	schemes := p.Schemes
+	if len(schemes) == 0 {
+		schemes = DefaultSchemes
+	}
+	if !containsFold(schemes, u.Scheme) {
+		return fmt.Errorf("%w: scheme %q", models.ErrForbiddenURL, u.Scheme)
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "" {
+		return fmt.Errorf("%w: empty host", models.ErrForbiddenURL)
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		if len(p.AllowHosts) > 0 && !matchIP(p.AllowHosts, ip) {
+			return fmt.Errorf("%w: address %s", models.ErrForbiddenURL, ip)
+		}
+		return p.CheckIP(ip)
+	}
+
+	if matchHost(p.DenyHosts, host) {
+		return fmt.Errorf("%w: host %q", models.ErrForbiddenURL, host)
+	}
+	if len(p.AllowHosts) > 0 && !matchHost(p.AllowHosts, host) {
+		return fmt.Errorf("%w: host %q", models.ErrForbiddenURL, host)
+	}
+
+	return nil
+}
+
+// CheckIP checks address the host is resolved to.
+func (p URLPolicy) CheckIP(ip net.IP) error {
+	if matchIP(p.DenyHosts, ip) {
+		return fmt.Errorf("%w: address %s", models.ErrForbiddenURL, ip)
+	}
+	if !p.AllowPrivate && isPrivate(ip) && !matchIP(p.AllowHosts, ip) {
+		return fmt.Errorf("%w: private address %s", models.ErrForbiddenURL, ip)
+	}
+
+	return nil
+}
+
+// NewHttpClient returns client which checks every redirect by CheckURL and
+// every dialed address by CheckIP, so domains resolved to private
+// addresses are blocked too. Proxies from environment aren't used, they
+// would hide the address of host.
+func NewHttpClient(policy URLPolicy) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil {
+				return fmt.Errorf("%w: address %q", models.ErrForbiddenURL, host)
+			}
+			return policy.CheckIP(ip)
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= MaxRedirects {
+				return fmt.Errorf("stopped after %d redirects", MaxRedirects)
+			}
+			return policy.CheckURL(req.URL)
+		},
+	}
+}
+
+func isPrivate(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return true
+	}
+	for _, n := range privateNets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchHost(hosts []string, host string) bool {
+	for _, h := range hosts {
+		h = strings.TrimSuffix(strings.ToLower(h), ".")
+		if parseNet(h) == nil && (host == h || strings.HasSuffix(host, "."+h)) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchIP(hosts []string, ip net.IP) bool {
+	for _, h := range hosts {
+		if n := parseNet(h); n != nil && n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseNet parses IP or CIDR, nil means domain.
+func parseNet(host string) *net.IPNet {
+	if ip := net.ParseIP(host); ip != nil {
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 8*net.IPv4len
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+	}
+	_, n, err := net.ParseCIDR(host)
+	if err != nil {
+		return nil
+	}
+	return n
+}
+
+func mustParseNets(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, n)
+	}
+	return nets
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,246 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/roman-wb/price-service/internal/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURLPolicyCheckURL(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		policy URLPolicy
+		url    string
+
+		wantErr error
+	}{
+		{
+			name: "Public host",
+
+			url: "https://yandex.ru/price.csv",
+
+			wantErr: nil,
+		},
+		{
+			name: "Scheme isn't allowed",
+
+			url: "file:///etc/passwd",
+
+			wantErr: errors.New(`forbidden url: scheme "file"`),
+		},
+		{
+			name: "Scheme is allowed by policy",
+
+			policy: URLPolicy{Schemes: []string{"https"}},
+			url:    "http://yandex.ru/price.csv",
+
+			wantErr: errors.New(`forbidden url: scheme "http"`),
+		},
+		{
+			name: "Loopback address",
+
+			url: "http://127.0.0.1:3000/price.csv",
+
+			wantErr: errors.New(`forbidden url: private address 127.0.0.1`),
+		},
+		{
+			name: "IPv6 loopback address",
+
+			url: "http://[::1]:3000/price.csv",
+
+			wantErr: errors.New(`forbidden url: private address ::1`),
+		},
+		{
+			name: "Link-local metadata address",
+
+			url: "http://169.254.169.254/latest/meta-data",
+
+			wantErr: errors.New(`forbidden url: private address 169.254.169.254`),
+		},
+		{
+			name: "Private address",
+
+			url: "http://10.1.2.3/price.csv",
+
+			wantErr: errors.New(`forbidden url: private address 10.1.2.3`),
+		},
+		{
+			name: "Private address is allowed by policy",
+
+			policy: URLPolicy{AllowPrivate: true},
+			url:    "http://10.1.2.3/price.csv",
+
+			wantErr: nil,
+		},
+		{
+			name: "Private address is allowed by allowlist",
+
+			policy: URLPolicy{AllowHosts: []string{"10.0.0.0/8"}},
+			url:    "http://10.1.2.3/price.csv",
+
+			wantErr: nil,
+		},
+		{
+			name: "Host isn't in allowlist",
+
+			policy: URLPolicy{AllowHosts: []string{"yandex.ru"}},
+			url:    "http://google.com/price.csv",
+
+			wantErr: errors.New(`forbidden url: host "google.com"`),
+		},
+		{
+			name: "Subdomain is in allowlist",
+
+			policy: URLPolicy{AllowHosts: []string{"yandex.ru"}},
+			url:    "http://market.YANDEX.ru./price.csv",
+
+			wantErr: nil,
+		},
+		{
+			name: "Address isn't in allowlist",
+
+			policy: URLPolicy{AllowHosts: []string{"yandex.ru"}},
+			url:    "http://8.8.8.8/price.csv",
+
+			wantErr: errors.New(`forbidden url: address 8.8.8.8`),
+		},
+		{
+			name: "Host is in denylist",
+
+			policy: URLPolicy{AllowHosts: []string{"yandex.ru"}, DenyHosts: []string{"internal.yandex.ru"}},
+			url:    "http://api.internal.yandex.ru/price.csv",
+
+			wantErr: errors.New(`forbidden url: host "api.internal.yandex.ru"`),
+		},
+		{
+			name: "Address is in denylist",
+
+			policy: URLPolicy{AllowPrivate: true, DenyHosts: []string{"10.0.0.0/8"}},
+			url:    "http://10.1.2.3/price.csv",
+
+			wantErr: errors.New(`forbidden url: address 10.1.2.3`),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			u, err := url.Parse(tc.url)
+			require.Nil(t, err)
+
+			gotErr := tc.policy.CheckURL(u)
+
+			if tc.wantErr == nil {
+				require.Nil(t, gotErr)
+				return
+			}
+			require.True(t, errors.Is(gotErr, models.ErrForbiddenURL))
+			require.Equal(t, tc.wantErr.Error(), gotErr.Error())
+		})
+	}
+}
+
+func TestNewHttpClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, r.URL.Query().Get("to"), http.StatusFound)
+			return
+		}
+		fmt.Fprint(w, "Product 1;1")
+	}))
+	t.Cleanup(server.Close)
+
+	_, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.Nil(t, err)
+	localhost := "http://localhost:" + port
+
+	testCases := []struct {
+		name string
+
+		policy URLPolicy
+		url    string
+
+		wantBody string
+		wantErr  error
+	}{
+		{
+			name: "Loopback address is blocked",
+
+			url: server.URL,
+
+			wantErr: errors.New(`forbidden url: private address 127.0.0.1`),
+		},
+		{
+			name: "Host resolved to loopback address is blocked",
+
+			url: localhost,
+
+			wantErr: errors.New(`forbidden url: private address`),
+		},
+		{
+			name: "Loopback address is allowed by policy",
+
+			policy: URLPolicy{AllowPrivate: true},
+			url:    localhost,
+
+			wantBody: "Product 1;1",
+		},
+		{
+			name: "Redirect to denied host is blocked",
+
+			policy: URLPolicy{AllowPrivate: true, DenyHosts: []string{"localhost"}},
+			url:    server.URL + "/redirect?to=" + url.QueryEscape(localhost),
+
+			wantErr: errors.New(`forbidden url: host "localhost"`),
+		},
+		{
+			name: "Redirect to denied address is blocked",
+
+			policy: URLPolicy{AllowHosts: []string{"localhost"}, DenyHosts: []string{"127.0.0.0/8"}},
+			url:    localhost + "/redirect?to=" + url.QueryEscape(server.URL),
+
+			wantErr: errors.New(`forbidden url: address 127.0.0.1`),
+		},
+		{
+			name: "Redirect to allowed host is followed",
+
+			policy: URLPolicy{AllowPrivate: true},
+			url:    server.URL + "/redirect?to=" + url.QueryEscape(localhost),
+
+			wantBody: "Product 1;1",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := NewHttpClient(tc.policy)
+
+			resp, gotErr := client.Get(tc.url)
+			if tc.wantErr != nil {
+				require.True(t, errors.Is(gotErr, models.ErrForbiddenURL), gotErr)
+				require.Contains(t, gotErr.Error(), tc.wantErr.Error())
+				return
+			}
+			require.Nil(t, gotErr)
+			defer resp.Body.Close()
+
+			gotBody, err := ioutil.ReadAll(resp.Body)
+			require.Nil(t, err)
+			require.Equal(t, tc.wantBody, string(gotBody))
+		})
+	}
+}
@@ -0,0 +1,313 @@
+package parser
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+const (
+	xlsxWorkbook      = "xl/workbook.xml"
+	xlsxWorkbookRels  = "xl/_rels/workbook.xml.rels"
+	xlsxSharedStrings = "xl/sharedStrings.xml"
+)
+
+// xlsxDecoder reads rows of one sheet. Xlsx is zip archive, so body is
+// saved to temporary file first. Rows of sheet are decoded one by one,
+// only shared strings are kept in memory. Every unpacked file of archive
+// is limited by maxBytes like unpacked compressed feeds.
+type xlsxDecoder struct {
+	opts     xlsxOptions
+	maxBytes int64
+	file     *tempFile
+	sheet    io.ReadCloser
+	decoder  *xml.Decoder
+	strings  []string
+	line     int
+	started  bool
+}
+
+func newXLSXDecoder(r io.Reader, opts xlsxOptions, maxBytes int64) (*xlsxDecoder, error) {
+	file, err := newTempFile(r, "price-*.xlsx")
+	if err != nil {
+		return nil, err
+	}
+
+	d := &xlsxDecoder{opts: opts, maxBytes: maxBytes, file: file}
+	err = d.open()
+	if err != nil {
+		d.Close()
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func (d *xlsxDecoder) open() error {
+	archive, err := zip.NewReader(d.file, d.file.size)
+	if err != nil {
+		return fmt.Errorf("invalid xlsx: %v", err)
+	}
+
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, f := range archive.File {
+		files[f.Name] = f
+	}
+
+	sheetPath, err := xlsxSheetPath(files, d.opts.sheet, d.maxBytes)
+	if err != nil {
+		return err
+	}
+
+	d.strings, err = xlsxSharedStringsOf(files, d.maxBytes)
+	if err != nil {
+		return err
+	}
+
+	sheet, ok := files[sheetPath]
+	if !ok {
+		return fmt.Errorf("invalid xlsx: missing %s", sheetPath)
+	}
+	d.sheet, err = sheet.Open()
+	if err != nil {
+		return err
+	}
+	d.decoder = xml.NewDecoder(newLimitedReader(d.sheet, d.maxBytes))
+
+	return nil
+}
+
+func (d *xlsxDecoder) Decode() (row, error) {
+	for {
+		line, cells, err := d.readRow()
+		if err != nil {
+			return row{}, err
+		}
+		if len(cells) == 0 {
+			continue
+		}
+
+		if !d.started {
+			d.started = true
+			if d.opts.header {
+				continue
+			}
+		}
+
+		price := cells[d.opts.columns.price]
+		return row{
+			line:     line,
+			name:     cells[d.opts.columns.name].text,
+			price:    price.text,
+			number:   price.number,
+			currency: cells[d.opts.columns.currency].text,
+		}, nil
+	}
+}
+
+// readRow returns number of next row and its cells with values. Cells
+// are empty if row has no values.
+func (d *xlsxDecoder) readRow() (int, map[int]xlsxValue, error) {
+	for {
+		token, err := d.decoder.Token()
+		if err != nil {
+			return 0, nil, err
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "row" {
+			continue
+		}
+
+		var r xlsxRow
+		err = d.decoder.DecodeElement(&r, &start)
+		if err != nil {
+			return 0, nil, err
+		}
+		d.line++
+		if r.Number > 0 {
+			d.line = r.Number
+		}
+
+		cells := make(map[int]xlsxValue, len(r.Cells))
+		for i, c := range r.Cells {
+			column := i
+			if c.Ref != "" {
+				column = xlsxColumn(c.Ref)
+			}
+
+			value, err := c.value(d.strings)
+			if err != nil {
+				return 0, nil, &rowError{line: d.line, reason: err.Error()}
+			}
+			if value.text != "" {
+				cells[column] = value
+			}
+		}
+
+		return d.line, cells, nil
+	}
+}
+
+func (d *xlsxDecoder) Close() error {
+	if d.sheet != nil {
+		d.sheet.Close()
+	}
+	return d.file.Close()
+}
+
+type xlsxRow struct {
+	Number int        `xml:"r,attr"`
+	Cells  []xlsxCell `xml:"c"`
+}
+
+type xlsxCell struct {
+	Ref    string   `xml:"r,attr"`
+	Type   string   `xml:"t,attr"`
+	Value  string   `xml:"v"`
+	Inline xlsxText `xml:"is"`
+}
+
+// xlsxValue is text of cell, number is set for cells of number type.
+type xlsxValue struct {
+	text   string
+	number bool
+}
+
+func (c xlsxCell) value(sharedStrings []string) (xlsxValue, error) {
+	switch c.Type {
+	case "s":
+		i, err := strconv.Atoi(c.Value)
+		if err != nil || i < 0 || i >= len(sharedStrings) {
+			return xlsxValue{}, fmt.Errorf("invalid shared string %q", c.Value)
+		}
+		return xlsxValue{text: sharedStrings[i]}, nil
+	case "inlineStr":
+		return xlsxValue{text: c.Inline.String()}, nil
+	case "e":
+		return xlsxValue{}, fmt.Errorf("cell %s has error %s", c.Ref, c.Value)
+	case "", "n":
+		return xlsxValue{text: c.Value, number: true}, nil
+	default:
+		return xlsxValue{text: c.Value}, nil
+	}
+}
+
+// xlsxText is rich text, plain text is in T and formatted runs in R.
+type xlsxText struct {
+	T string `xml:"t"`
+	R []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	var s strings.Builder
+	s.WriteString(t.T)
+	for _, r := range t.R {
+		s.WriteString(r.T)
+	}
+	return s.String()
+}
+
+// xlsxColumn converts reference of cell like "AB12" to column index.
+func xlsxColumn(ref string) int {
+	column := 0
+	for _, ch := range ref {
+		if ch < 'A' || ch > 'Z' {
+			break
+		}
+		column = column*26 + int(ch-'A') + 1
+	}
+	return column - 1
+}
+
+// xlsxSheetPath finds file of sheet by name, first sheet if name is empty.
+func xlsxSheetPath(files map[string]*zip.File, name string, maxBytes int64) (string, error) {
+	var workbook struct {
+		Sheets []struct {
+			Name string `xml:"name,attr"`
+			ID   string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	err := xlsxUnmarshal(files, xlsxWorkbook, &workbook, maxBytes)
+	if err != nil {
+		return "", err
+	}
+
+	id := ""
+	for _, sheet := range workbook.Sheets {
+		if name == "" || sheet.Name == name {
+			id = sheet.ID
+			break
+		}
+	}
+	if id == "" {
+		if name == "" {
+			return "", fmt.Errorf("xlsx has no sheets")
+		}
+		return "", fmt.Errorf("xlsx sheet %q not found", name)
+	}
+
+	var rels struct {
+		Relationships []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+	err = xlsxUnmarshal(files, xlsxWorkbookRels, &rels, maxBytes)
+	if err != nil {
+		return "", err
+	}
+
+	for _, rel := range rels.Relationships {
+		if rel.ID == id {
+			if strings.HasPrefix(rel.Target, "/") {
+				return strings.TrimPrefix(rel.Target, "/"), nil
+			}
+			return path.Join(path.Dir(xlsxWorkbook), rel.Target), nil
+		}
+	}
+	return "", fmt.Errorf("invalid xlsx: missing relationship %s", id)
+}
+
+// xlsxSharedStringsOf reads table of strings, workbook without strings
+// has no such file.
+func xlsxSharedStringsOf(files map[string]*zip.File, maxBytes int64) ([]string, error) {
+	if _, ok := files[xlsxSharedStrings]; !ok {
+		return nil, nil
+	}
+
+	var sst struct {
+		Items []xlsxText `xml:"si"`
+	}
+	err := xlsxUnmarshal(files, xlsxSharedStrings, &sst, maxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	strs := make([]string, len(sst.Items))
+	for i, item := range sst.Items {
+		strs[i] = item.String()
+	}
+	return strs, nil
+}
+
+func xlsxUnmarshal(files map[string]*zip.File, name string, v interface{}, maxBytes int64) error {
+	f, ok := files[name]
+	if !ok {
+		return fmt.Errorf("invalid xlsx: missing %s", name)
+	}
+
+	r, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	return xml.NewDecoder(newLimitedReader(r, maxBytes)).Decode(v)
+}
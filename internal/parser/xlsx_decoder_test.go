@@ -0,0 +1,135 @@
+package parser
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/roman-wb/price-service/internal/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXLSXDecoderDecode(t *testing.T) {
+	workbook, err := ioutil.ReadFile("testdata/prices.xlsx")
+	require.Nil(t, err)
+
+	testCases := []struct {
+		name string
+
+		body   io.Reader
+		format models.XLSXFormat
+
+		wantRows []row
+		wantErr  error
+	}{
+		{
+			name: "Invalid xlsx",
+
+			body: strings.NewReader("Product 1;1"),
+
+			wantErr: errors.New("invalid xlsx: zip: not a valid zip file"),
+		},
+		{
+			name: "Sheet not found",
+
+			body:   bytes.NewReader(workbook),
+			format: models.XLSXFormat{Sheet: "Archive"},
+
+			wantErr: errors.New(`xlsx sheet "Archive" not found`),
+		},
+		{
+			name: "First sheet",
+
+			body: bytes.NewReader(workbook),
+
+			wantRows: []row{
+				{line: 1, name: "Info", price: "1", number: true},
+				{line: 2, name: "Product 1", price: "7"},
+			},
+		},
+		{
+			name: "Sheet with header and columns",
+
+			body:   bytes.NewReader(workbook),
+			format: models.XLSXFormat{Sheet: "Prices", Header: true, NameColumn: 2, PriceColumn: 3},
+
+			wantRows: []row{
+				{line: 2, name: "Product 1", price: "10.5", number: true},
+				{line: 3, name: "Product 2", price: "20"},
+				{line: 5, name: "Product 3", price: "error"},
+				{line: 6, name: "", price: "5", number: true},
+				{line: 9, name: "Product 6", price: "1.1E+3", number: true},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, err := newXLSXOptions(tc.format)
+			require.Nil(t, err)
+
+			decoder, gotErr := newXLSXDecoder(tc.body, opts, 0)
+			if tc.wantErr != nil {
+				require.Nil(t, decoder)
+				require.Equal(t, tc.wantErr.Error(), gotErr.Error())
+				return
+			}
+			require.Nil(t, gotErr)
+
+			var gotRows []row
+			for {
+				gotRow, err := decoder.Decode()
+				if err == io.EOF {
+					break
+				}
+				if _, ok := err.(*rowError); ok {
+					continue
+				}
+				require.Nil(t, err)
+				gotRows = append(gotRows, gotRow)
+			}
+			require.Equal(t, tc.wantRows, gotRows)
+
+			require.Nil(t, decoder.Close())
+			_, err = os.Stat(decoder.file.Name())
+			require.True(t, os.IsNotExist(err))
+		})
+	}
+}
+
+func TestXLSXDecoderLimits(t *testing.T) {
+	workbook, err := ioutil.ReadFile("testdata/prices.xlsx")
+	require.Nil(t, err)
+
+	opts, err := newXLSXOptions(models.XLSXFormat{Sheet: "Prices", Header: true, NameColumn: 2, PriceColumn: 3})
+	require.Nil(t, err)
+
+	t.Run("Workbook over limit", func(t *testing.T) {
+		decoder, err := newXLSXDecoder(bytes.NewReader(workbook), opts, 100)
+		require.Nil(t, decoder)
+		require.True(t, errors.Is(err, models.ErrLimitExceeded))
+		require.Equal(t, "limit exceeded: feed is larger than 100 bytes", err.Error())
+	})
+
+	t.Run("Sheet over limit", func(t *testing.T) {
+		decoder, err := newXLSXDecoder(bytes.NewReader(workbook), opts, 600)
+		require.Nil(t, err)
+		defer decoder.Close()
+
+		for {
+			_, err = decoder.Decode()
+			if _, ok := err.(*rowError); err == nil || ok {
+				continue
+			}
+			break
+		}
+		require.True(t, errors.Is(err, models.ErrLimitExceeded))
+	})
+}
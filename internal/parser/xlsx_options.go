@@ -0,0 +1,29 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/roman-wb/price-service/internal/models"
+)
+
+// xlsxOptions is validated models.XLSXFormat with defaults.
+type xlsxOptions struct {
+	sheet   string
+	header  bool
+	columns columns
+}
+
+func newXLSXOptions(format models.XLSXFormat) (xlsxOptions, error) {
+	opts := xlsxOptions{
+		sheet:  format.Sheet,
+		header: format.Header,
+	}
+
+	cols, err := newColumns(format.NameColumn, format.PriceColumn, format.CurrencyColumn)
+	if err != nil {
+		return opts, fmt.Errorf("xlsx %v", err)
+	}
+	opts.columns = cols
+
+	return opts, nil
+}
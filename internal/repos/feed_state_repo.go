@@ -0,0 +1,43 @@
+package repos
+
+import (
+	"context"
+
+	"github.com/roman-wb/price-service/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const FeedStateCollection = "feed_states"
+
+type FeedStateRepo struct {
+	collection *mongo.Collection
+}
+
+func NewFeedStateRepo(db *mongo.Database) *FeedStateRepo {
+	return &FeedStateRepo{
+		collection: db.Collection(FeedStateCollection),
+	}
+}
+
+// Get returns nil when url wasn't imported yet.
+func (fr *FeedStateRepo) Get(ctx context.Context, url string) (*models.FeedState, error) {
+	var state models.FeedState
+	err := fr.collection.FindOne(ctx, bson.M{"url": url}).Decode(&state)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &state, nil
+}
+
+// Set replaces state of the url.
+func (fr *FeedStateRepo) Set(ctx context.Context, state models.FeedState) error {
+	opts := options.Replace().SetUpsert(true)
+	_, err := fr.collection.ReplaceOne(ctx, bson.M{"url": state.URL}, state, opts)
+	return err
+}
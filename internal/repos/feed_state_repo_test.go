@@ -0,0 +1,82 @@
+package repos_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/roman-wb/price-service/internal/database"
+	"github.com/roman-wb/price-service/internal/models"
+	"github.com/roman-wb/price-service/internal/repos"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type FeedStateRepoTestSuite struct {
+	suite.Suite
+
+	client     *mongo.Client
+	db         *mongo.Database
+	collection *mongo.Collection
+}
+
+func (suite *FeedStateRepoTestSuite) ClearCollection() {
+	_, err := suite.collection.DeleteMany(context.Background(), bson.M{}, nil)
+	suite.Require().Nil(err)
+}
+
+func (suite *FeedStateRepoTestSuite) SetupTest() {
+	client, err := database.NewClient(context.Background(), MongoURI, "file://../../migrations")
+	suite.Require().Nil(err)
+
+	suite.client = client
+	suite.db = suite.client.Database(MongoDB)
+	suite.collection = suite.db.Collection(repos.FeedStateCollection)
+
+	suite.ClearCollection()
+}
+
+func (suite *FeedStateRepoTestSuite) TearDownSuite() {
+	suite.ClearCollection()
+}
+
+func TestFeedStateRepo(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	suite.Run(t, &FeedStateRepoTestSuite{})
+}
+
+func (suite *FeedStateRepoTestSuite) TestGetSet() {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	repo := repos.NewFeedStateRepo(suite.db)
+
+	got, err := repo.Get(context.Background(), "http://yandex.ru")
+	suite.Require().Nil(err)
+	suite.Require().Nil(got)
+
+	state := models.FeedState{
+		URL:       "http://yandex.ru",
+		Feed:      models.Feed{URL: "http://yandex.ru"},
+		ETag:      `"v1"`,
+		Hash:      "hash1",
+		UpdatedAt: now,
+	}
+	err = repo.Set(context.Background(), state)
+	suite.Require().Nil(err)
+
+	state.ETag = `"v2"`
+	state.Hash = "hash2"
+	err = repo.Set(context.Background(), state)
+	suite.Require().Nil(err)
+
+	got, err = repo.Get(context.Background(), "http://yandex.ru")
+	suite.Require().Nil(err)
+	suite.Require().Equal(state, *got)
+
+	count, err := suite.collection.CountDocuments(context.Background(), bson.M{})
+	suite.Require().Nil(err)
+	suite.Require().Equal(int64(1), count)
+}
@@ -0,0 +1,163 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"github.com/roman-wb/price-service/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const JobCollection = "jobs"
+
+type JobRepo struct {
+	collection *mongo.Collection
+}
+
+func NewJobRepo(db *mongo.Database) *JobRepo {
+	return &JobRepo{
+		collection: db.Collection(JobCollection),
+	}
+}
+
+func (jr *JobRepo) Create(ctx context.Context, job models.Job) (models.Job, error) {
+	result, err := jr.collection.InsertOne(ctx, job)
+	if err != nil {
+		return job, err
+	}
+
+	job.ID = result.InsertedID.(primitive.ObjectID)
+	return job, nil
+}
+
+// Claim moves the oldest queued job to running and locks it until
+// lockedUntil. Running job whose lock expired is claimed again, its
+// instance is gone. It returns nil when the queue is empty. The update is
+// atomic, so every job is claimed by a single worker of all service
+// instances. Every claim sets a new LockID, which is required by updates
+// of the job.
+//
+// Jobs of the same source run one at a time, otherwise older import
+// could stamp prices after newer snapshot and they'd be deleted as
+// missing. Queued job of source with running job is skipped, unique
+// index of running jobs by source rejects the claim of concurrent
+// worker.
+func (jr *JobRepo) Claim(ctx context.Context, startedAt time.Time, lockedUntil time.Time) (*models.Job, error) {
+	running, err := jr.collection.Distinct(ctx, "feed.source", bson.M{"state": models.JobRunning})
+	if err != nil {
+		return nil, err
+	}
+	busySources := bson.A{}
+	busySources = append(busySources, running...)
+
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var job models.Job
+	err = jr.collection.FindOneAndUpdate(
+		ctx,
+		bson.M{"$or": bson.A{
+			bson.M{"state": models.JobQueued, "feed.source": bson.M{"$nin": busySources}},
+			bson.M{"state": models.JobRunning, "locked_until": bson.M{"$lt": startedAt}},
+		}},
+		bson.M{"$set": bson.M{
+			"state":        models.JobRunning,
+			"started_at":   startedAt,
+			"locked_until": lockedUntil,
+			"lock_id":      primitive.NewObjectID(),
+		}},
+		opts,
+	).Decode(&job)
+	if err == mongo.ErrNoDocuments || mongo.IsDuplicateKeyError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// Extend moves lock of running job, so it isn't claimed by another
+// instance while it runs. Extend, Progress and Finish return
+// models.ErrJobLost when job is claimed by another worker since.
+func (jr *JobRepo) Extend(ctx context.Context, job models.Job, lockedUntil time.Time) error {
+	return jr.update(ctx, job, bson.M{"locked_until": lockedUntil})
+}
+
+func (jr *JobRepo) Progress(ctx context.Context, job models.Job) error {
+	return jr.update(ctx, job, bson.M{"processed": job.Processed})
+}
+
+func (jr *JobRepo) Finish(ctx context.Context, job models.Job) error {
+	return jr.update(ctx, job, bson.M{
+		"state":       job.State,
+		"processed":   job.Processed,
+		"stats":       job.Stats,
+		"error":       job.Error,
+		"error_code":  job.ErrorCode,
+		"unchanged":   job.Unchanged,
+		"finished_at": job.FinishedAt,
+	})
+}
+
+func (jr *JobRepo) update(ctx context.Context, job models.Job, set bson.M) error {
+	result, err := jr.collection.UpdateOne(
+		ctx,
+		bson.M{"_id": job.ID, "lock_id": job.LockID},
+		bson.M{"$set": set},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return models.ErrJobLost
+	}
+
+	return nil
+}
+
+// Get returns nil when job isn't found.
+func (jr *JobRepo) Get(ctx context.Context, id string) (*models.Job, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, &models.FieldError{Field: "id", Err: err}
+	}
+
+	var job models.Job
+	err = jr.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&job)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+func (jr *JobRepo) List(ctx context.Context, skip int, limit int) ([]models.Job, error) {
+	skip, limit = normalizePaging(skip, limit)
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSkip(int64(skip)).
+		SetLimit(int64(limit))
+
+	cursor, err := jr.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var jobs []models.Job
+	err = cursor.All(ctx, &jobs)
+	if err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
@@ -0,0 +1,278 @@
+package repos_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/roman-wb/price-service/internal/database"
+	"github.com/roman-wb/price-service/internal/models"
+	"github.com/roman-wb/price-service/internal/repos"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type JobRepoTestSuite struct {
+	suite.Suite
+
+	client     *mongo.Client
+	db         *mongo.Database
+	collection *mongo.Collection
+}
+
+func (suite *JobRepoTestSuite) ClearCollection() {
+	_, err := suite.collection.DeleteMany(context.Background(), bson.M{}, nil)
+	suite.Require().Nil(err)
+}
+
+func (suite *JobRepoTestSuite) SetupTest() {
+	client, err := database.NewClient(context.Background(), MongoURI, "file://../../migrations")
+	suite.Require().Nil(err)
+
+	suite.client = client
+	suite.db = suite.client.Database(MongoDB)
+	suite.collection = suite.db.Collection(repos.JobCollection)
+
+	suite.ClearCollection()
+}
+
+func (suite *JobRepoTestSuite) TearDownSuite() {
+	suite.ClearCollection()
+}
+
+func TestJobRepo(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	suite.Run(t, &JobRepoTestSuite{})
+}
+
+func (suite *JobRepoTestSuite) TestLifecycle() {
+	now := time.Now().UTC()
+	repo := repos.NewJobRepo(suite.db)
+
+	job, err := repo.Create(context.Background(), models.Job{Feed: models.Feed{URL: "http://yandex.ru"}, State: models.JobQueued, CreatedAt: now})
+	suite.Require().Nil(err)
+	suite.Require().False(job.ID.IsZero())
+
+	claimed, err := repo.Claim(context.Background(), now, now.Add(time.Minute))
+	suite.Require().Nil(err)
+	suite.Require().NotNil(claimed)
+	suite.Require().Equal(job.ID, claimed.ID)
+	suite.Require().Equal(models.JobRunning, claimed.State)
+	suite.Require().False(claimed.LockID.IsZero())
+	job = *claimed
+
+	claimed, err = repo.Claim(context.Background(), now, now.Add(time.Minute))
+	suite.Require().Nil(err)
+	suite.Require().Nil(claimed)
+
+	job.Processed = 10
+	err = repo.Progress(context.Background(), job)
+	suite.Require().Nil(err)
+
+	got, err := repo.Get(context.Background(), job.ID.Hex())
+	suite.Require().Nil(err)
+	suite.Require().Equal(10, got.Processed)
+
+	job.State = models.JobDone
+	job.Processed = 20
+	job.Stats = models.ImportStats{Parsed: 20, Inserted: 20}
+	job.FinishedAt = now
+	err = repo.Finish(context.Background(), job)
+	suite.Require().Nil(err)
+
+	got, err = repo.Get(context.Background(), job.ID.Hex())
+	suite.Require().Nil(err)
+	suite.Require().Equal(models.JobDone, got.State)
+	suite.Require().Equal(20, got.Processed)
+	suite.Require().Equal(20, got.Stats.Inserted)
+	suite.Require().Equal(now.Truncate(time.Second), got.FinishedAt.Truncate(time.Second))
+}
+
+func (suite *JobRepoTestSuite) TestClaimExpiredLock() {
+	now := time.Now().UTC()
+	repo := repos.NewJobRepo(suite.db)
+
+	suite.ClearCollection()
+
+	job, err := repo.Create(context.Background(), models.Job{Feed: models.Feed{URL: "http://yandex.ru"}, State: models.JobQueued, CreatedAt: now})
+	suite.Require().Nil(err)
+
+	claimed, err := repo.Claim(context.Background(), now, now.Add(time.Minute))
+	suite.Require().Nil(err)
+	suite.Require().Equal(job.ID, claimed.ID)
+
+	job = *claimed
+	err = repo.Extend(context.Background(), job, now.Add(2*time.Minute))
+	suite.Require().Nil(err)
+
+	// Lock is extended
+	claimed, err = repo.Claim(context.Background(), now.Add(90*time.Second), now.Add(3*time.Minute))
+	suite.Require().Nil(err)
+	suite.Require().Nil(claimed)
+
+	// Instance of job is gone
+	claimed, err = repo.Claim(context.Background(), now.Add(3*time.Minute), now.Add(4*time.Minute))
+	suite.Require().Nil(err)
+	suite.Require().NotNil(claimed)
+	suite.Require().Equal(job.ID, claimed.ID)
+	suite.Require().Equal(models.JobRunning, claimed.State)
+	suite.Require().Equal(now.Add(4*time.Minute).Truncate(time.Millisecond), claimed.LockedUntil)
+	suite.Require().NotEqual(job.LockID, claimed.LockID)
+
+	// Worker of expired lock can't update job
+	err = repo.Extend(context.Background(), job, now.Add(5*time.Minute))
+	suite.Require().Equal(models.ErrJobLost, err)
+	job.Processed = 10
+	err = repo.Progress(context.Background(), job)
+	suite.Require().Equal(models.ErrJobLost, err)
+	job.State = models.JobFailed
+	err = repo.Finish(context.Background(), job)
+	suite.Require().Equal(models.ErrJobLost, err)
+
+	job = *claimed
+	job.State = models.JobDone
+	err = repo.Finish(context.Background(), job)
+	suite.Require().Nil(err)
+
+	claimed, err = repo.Claim(context.Background(), now.Add(time.Hour), now.Add(time.Hour))
+	suite.Require().Nil(err)
+	suite.Require().Nil(claimed)
+}
+
+func (suite *JobRepoTestSuite) TestClaimSourceOneAtATime() {
+	now := time.Now().UTC()
+	repo := repos.NewJobRepo(suite.db)
+
+	older, err := repo.Create(context.Background(), models.Job{Feed: models.Feed{URL: "http://yandex.ru/1", Source: "shop1"}, State: models.JobQueued, CreatedAt: now})
+	suite.Require().Nil(err)
+	newer, err := repo.Create(context.Background(), models.Job{Feed: models.Feed{URL: "http://yandex.ru/2", Source: "shop1", Snapshot: true}, State: models.JobQueued, CreatedAt: now.Add(time.Second)})
+	suite.Require().Nil(err)
+	other, err := repo.Create(context.Background(), models.Job{Feed: models.Feed{URL: "http://yandex.ru/3", Source: "shop2"}, State: models.JobQueued, CreatedAt: now.Add(2 * time.Second)})
+	suite.Require().Nil(err)
+
+	claimed, err := repo.Claim(context.Background(), now, now.Add(time.Minute))
+	suite.Require().Nil(err)
+	suite.Require().Equal(older.ID, claimed.ID)
+	older = *claimed
+
+	// Snapshot of shop1 waits for the older import of shop1
+	claimed, err = repo.Claim(context.Background(), now, now.Add(time.Minute))
+	suite.Require().Nil(err)
+	suite.Require().Equal(other.ID, claimed.ID)
+
+	claimed, err = repo.Claim(context.Background(), now, now.Add(time.Minute))
+	suite.Require().Nil(err)
+	suite.Require().Nil(claimed)
+
+	// Concurrent claim of job of running source is rejected by index
+	_, err = suite.collection.UpdateOne(context.Background(), bson.M{"_id": newer.ID}, bson.M{"$set": bson.M{"state": models.JobRunning}})
+	suite.Require().True(mongo.IsDuplicateKeyError(err), err)
+
+	older.State = models.JobDone
+	err = repo.Finish(context.Background(), older)
+	suite.Require().Nil(err)
+
+	claimed, err = repo.Claim(context.Background(), now, now.Add(time.Minute))
+	suite.Require().Nil(err)
+	suite.Require().Equal(newer.ID, claimed.ID)
+}
+
+func (suite *JobRepoTestSuite) TestFinish() {
+	now := time.Now().UTC()
+	repo := repos.NewJobRepo(suite.db)
+
+	job, err := repo.Create(context.Background(), models.Job{Feed: models.Feed{URL: "http://yandex.ru"}, State: models.JobRunning, CreatedAt: now})
+	suite.Require().Nil(err)
+
+	job.State = models.JobDone
+	job.Unchanged = true
+	job.FinishedAt = now
+	err = repo.Finish(context.Background(), job)
+	suite.Require().Nil(err)
+
+	got, err := repo.Get(context.Background(), job.ID.Hex())
+	suite.Require().Nil(err)
+	suite.Require().Equal(models.JobDone, got.State)
+	suite.Require().True(got.Unchanged)
+
+	job.State = models.JobFailed
+	job.Unchanged = false
+	job.Error = "limit exceeded: feed is larger than 10 bytes"
+	job.ErrorCode = "ResourceExhausted"
+	err = repo.Finish(context.Background(), job)
+	suite.Require().Nil(err)
+
+	got, err = repo.Get(context.Background(), job.ID.Hex())
+	suite.Require().Nil(err)
+	suite.Require().Equal(models.JobFailed, got.State)
+	suite.Require().False(got.Unchanged)
+	suite.Require().Equal("limit exceeded: feed is larger than 10 bytes", got.Error)
+	suite.Require().Equal("ResourceExhausted", got.ErrorCode)
+}
+
+func (suite *JobRepoTestSuite) TestGet() {
+	repo := repos.NewJobRepo(suite.db)
+
+	got, err := repo.Get(context.Background(), primitive.NewObjectID().Hex())
+	suite.Require().Nil(err)
+	suite.Require().Nil(got)
+
+	got, err = repo.Get(context.Background(), "invalid")
+	suite.Require().True(errors.Is(err, primitive.ErrInvalidHex), err)
+	var fieldErr *models.FieldError
+	suite.Require().True(errors.As(err, &fieldErr))
+	suite.Require().Equal("id", fieldErr.Field)
+	suite.Require().Nil(got)
+}
+
+func (suite *JobRepoTestSuite) TestList() {
+	now := time.Now().UTC()
+	repo := repos.NewJobRepo(suite.db)
+
+	for i, url := range []string{"http://yandex.ru/1", "http://yandex.ru/2", "http://yandex.ru/3"} {
+		_, err := repo.Create(context.Background(), models.Job{Feed: models.Feed{URL: url}, State: models.JobQueued, CreatedAt: now.Add(time.Duration(i) * time.Minute)})
+		suite.Require().Nil(err)
+	}
+
+	testCases := []struct {
+		name string
+
+		skip  int
+		limit int
+
+		wantURLs []string
+	}{
+		{
+			name: "Newest first",
+
+			wantURLs: []string{"http://yandex.ru/3", "http://yandex.ru/2", "http://yandex.ru/1"},
+		},
+		{
+			name: "Page",
+
+			skip:  1,
+			limit: 1,
+
+			wantURLs: []string{"http://yandex.ru/2"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			gotJobs, err := repo.List(context.Background(), tc.skip, tc.limit)
+			suite.Require().Nil(err)
+
+			suite.Require().Equal(len(tc.wantURLs), len(gotJobs))
+			for i := range tc.wantURLs {
+				suite.Require().Equal(tc.wantURLs[i], gotJobs[i].Feed.URL)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package repos
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/roman-wb/price-service/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// pageToken points to the last price of a page. Token is bound to the
+// order, currency and best price mode, so it can't be used with another
+// ones. Token is json with value as string, it's parsed by type of the
+// order field, so any token of client is decoded safely.
+type pageToken struct {
+	OrderBy   string `json:"o"`
+	OrderType int32  `json:"t"`
+	Currency  string `json:"c,omitempty"`
+	BestPrice bool   `json:"b,omitempty"`
+	RawValue  string `json:"v"`
+	RawID     string `json:"id"`
+
+	Value interface{}        `json:"-"`
+	ID    primitive.ObjectID `json:"-"`
+}
+
+func newPageToken(query models.PriceQuery, price models.Price) pageToken {
+	token := pageToken{
+		OrderBy:   query.OrderBy,
+		OrderType: query.OrderType,
+		Currency:  query.Currency,
+		BestPrice: query.BestPrice,
+		ID:        price.ID,
+	}
+
+	switch query.OrderBy {
+	case "price":
+		token.Value = price.Price
+	case "changes":
+		token.Value = int64(price.Changes)
+	case "updated_at":
+		token.Value = primitive.NewDateTimeFromTime(price.UpdatedAt)
+	default:
+		token.Value = price.Name
+	}
+
+	return token
+}
+
+func (t pageToken) encode() (string, error) {
+	switch value := t.Value.(type) {
+	case primitive.Decimal128:
+		t.RawValue = value.String()
+	case int64:
+		t.RawValue = strconv.FormatInt(value, 10)
+	case primitive.DateTime:
+		t.RawValue = strconv.FormatInt(int64(value), 10)
+	case string:
+		t.RawValue = value
+	default:
+		return "", fmt.Errorf("unsupported page token value %T", t.Value)
+	}
+	t.RawID = t.ID.Hex()
+
+	data, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodePageToken(raw string, query models.PriceQuery) (pageToken, error) {
+	var token pageToken
+
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return token, models.ErrInvalidPageToken
+	}
+
+	err = json.Unmarshal(data, &token)
+	if err != nil || token.OrderBy != query.OrderBy || token.OrderType != query.OrderType ||
+		token.Currency != query.Currency || token.BestPrice != query.BestPrice {
+		return token, models.ErrInvalidPageToken
+	}
+
+	token.ID, err = primitive.ObjectIDFromHex(token.RawID)
+	if err != nil {
+		return token, models.ErrInvalidPageToken
+	}
+
+	switch token.OrderBy {
+	case "price":
+		token.Value, err = primitive.ParseDecimal128(token.RawValue)
+	case "changes":
+		token.Value, err = strconv.ParseInt(token.RawValue, 10, 64)
+	case "updated_at":
+		var ms int64
+		ms, err = strconv.ParseInt(token.RawValue, 10, 64)
+		token.Value = primitive.DateTime(ms)
+	default:
+		token.Value = token.RawValue
+	}
+	if err != nil {
+		return token, models.ErrInvalidPageToken
+	}
+
+	return token, nil
+}
+
+// match selects prices after the token in the order.
+func (t pageToken) match() bson.M {
+	op := "$gt"
+	if t.OrderType == -1 {
+		op = "$lt"
+	}
+
+	return bson.M{"$or": bson.A{
+		bson.M{t.OrderBy: bson.M{op: t.Value}},
+		bson.M{t.OrderBy: t.Value, "_id": bson.M{op: t.ID}},
+	}}
+}
@@ -0,0 +1,146 @@
+package repos
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/roman-wb/price-service/internal/models"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestPageToken(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	id := primitive.NewObjectID()
+	price := models.Price{ID: id, Name: "Product 1", Price: models.MustParsePrice("100.99"), Changes: 11, UpdatedAt: now}
+
+	testCases := []struct {
+		name string
+
+		orderBy   string
+		orderType int32
+
+		wantValue interface{}
+		wantMatch bson.M
+	}{
+		{
+			name: "Order by name asc",
+
+			orderBy:   "name",
+			orderType: 1,
+
+			wantValue: "Product 1",
+			wantMatch: bson.M{"$or": bson.A{
+				bson.M{"name": bson.M{"$gt": "Product 1"}},
+				bson.M{"name": "Product 1", "_id": bson.M{"$gt": id}},
+			}},
+		},
+		{
+			name: "Order by price desc",
+
+			orderBy:   "price",
+			orderType: -1,
+
+			wantValue: price.Price,
+			wantMatch: bson.M{"$or": bson.A{
+				bson.M{"price": bson.M{"$lt": price.Price}},
+				bson.M{"price": price.Price, "_id": bson.M{"$lt": id}},
+			}},
+		},
+		{
+			name: "Order by changes asc",
+
+			orderBy:   "changes",
+			orderType: 1,
+
+			wantValue: int64(11),
+			wantMatch: bson.M{"$or": bson.A{
+				bson.M{"changes": bson.M{"$gt": int64(11)}},
+				bson.M{"changes": int64(11), "_id": bson.M{"$gt": id}},
+			}},
+		},
+		{
+			name: "Order by updated_at desc",
+
+			orderBy:   "updated_at",
+			orderType: -1,
+
+			wantValue: primitive.NewDateTimeFromTime(now),
+			wantMatch: bson.M{"$or": bson.A{
+				bson.M{"updated_at": bson.M{"$lt": primitive.NewDateTimeFromTime(now)}},
+				bson.M{"updated_at": primitive.NewDateTimeFromTime(now), "_id": bson.M{"$lt": id}},
+			}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			query := models.PriceQuery{OrderBy: tc.orderBy, OrderType: tc.orderType}
+
+			raw, err := newPageToken(query, price).encode()
+			require.Nil(t, err)
+
+			gotToken, gotErr := decodePageToken(raw, query)
+			require.Nil(t, gotErr)
+			require.Equal(t, tc.wantValue, gotToken.Value)
+			require.Equal(t, id, gotToken.ID)
+			require.Equal(t, tc.wantMatch, gotToken.match())
+
+			_, gotErr = decodePageToken(raw, models.PriceQuery{OrderBy: tc.orderBy, OrderType: -tc.orderType})
+			require.Equal(t, models.ErrInvalidPageToken, gotErr)
+
+			_, gotErr = decodePageToken(raw, models.PriceQuery{OrderBy: tc.orderBy, OrderType: tc.orderType, Currency: "USD"})
+			require.Equal(t, models.ErrInvalidPageToken, gotErr)
+
+			_, gotErr = decodePageToken(raw, models.PriceQuery{OrderBy: tc.orderBy, OrderType: tc.orderType, BestPrice: true})
+			require.Equal(t, models.ErrInvalidPageToken, gotErr)
+		})
+	}
+}
+
+func TestDecodePageTokenInvalid(t *testing.T) {
+	_, err := decodePageToken("!invalid!", models.PriceQuery{OrderBy: "name", OrderType: 1})
+	require.Equal(t, models.ErrInvalidPageToken, err)
+
+	_, err = decodePageToken("aW52YWxpZA", models.PriceQuery{OrderBy: "name", OrderType: 1})
+	require.Equal(t, models.ErrInvalidPageToken, err)
+
+	// Valid base64 of broken bson, tokens were bson before
+	_, err = decodePageToken("MQAAAAJvAAUAAABuYW1lABCCAAEAAAACBwACAACyeAAHaWQAAAAAAAAAAAAAAAAAAA", models.PriceQuery{OrderBy: "name", OrderType: 1})
+	require.Equal(t, models.ErrInvalidPageToken, err)
+
+	// Value of wrong type
+	_, err = decodePageToken(base64.RawURLEncoding.EncodeToString([]byte(`{"o":"price","t":1,"v":"abc","id":"`+primitive.NewObjectID().Hex()+`"}`)), models.PriceQuery{OrderBy: "price", OrderType: 1})
+	require.Equal(t, models.ErrInvalidPageToken, err)
+
+	// Invalid id
+	_, err = decodePageToken(base64.RawURLEncoding.EncodeToString([]byte(`{"o":"name","t":1,"v":"Product 1","id":"1"}`)), models.PriceQuery{OrderBy: "name", OrderType: 1})
+	require.Equal(t, models.ErrInvalidPageToken, err)
+}
+
+func TestDecodePageTokenCorrupted(t *testing.T) {
+	query := models.PriceQuery{OrderBy: "name", OrderType: 1}
+	raw, err := newPageToken(query, models.Price{ID: primitive.NewObjectID(), Name: "Product 1"}).encode()
+	require.Nil(t, err)
+
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	require.Nil(t, err)
+
+	for i := range data {
+		for _, b := range []byte{0x00, 0x7f, 0x80, 0xff} {
+			corrupted := append([]byte{}, data...)
+			corrupted[i] = b
+			require.NotPanics(t, func() {
+				decodePageToken(base64.RawURLEncoding.EncodeToString(corrupted), query)
+			})
+		}
+		require.NotPanics(t, func() {
+			decodePageToken(base64.RawURLEncoding.EncodeToString(data[:i]), query)
+		})
+	}
+}
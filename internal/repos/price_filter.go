@@ -0,0 +1,77 @@
+package repos
+
+import (
+	"regexp"
+
+	"github.com/roman-wb/price-service/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// priceFilterMatch builds $match of the filter. Name prefix is an anchored
+// regex and ranges are plain comparisons, so they use indexes of fields.
+// Deleted prices never match.
+func priceFilterMatch(filter models.PriceFilter) bson.M {
+	match := bson.M{"deleted_at": notDeleted}
+
+	if filter.Source != "" {
+		match["source"] = filter.Source
+	}
+
+	name := bson.A{}
+	if filter.NamePrefix != "" {
+		name = append(name, bson.M{"name": bson.M{"$regex": "^" + regexp.QuoteMeta(filter.NamePrefix)}})
+	}
+	if filter.NameContains != "" {
+		name = append(name, bson.M{"name": bson.M{"$regex": regexp.QuoteMeta(filter.NameContains), "$options": "i"}})
+	}
+	if len(name) == 1 {
+		match["name"] = name[0].(bson.M)["name"]
+	}
+	if len(name) > 1 {
+		match["$and"] = name
+	}
+
+	for key, value := range priceRangeMatch(filter) {
+		match[key] = value
+	}
+
+	changes := bson.M{}
+	if filter.MinChanges != nil {
+		changes["$gte"] = *filter.MinChanges
+	}
+	if filter.MaxChanges != nil {
+		changes["$lte"] = *filter.MaxChanges
+	}
+	if len(changes) > 0 {
+		match["changes"] = changes
+	}
+
+	updatedAt := bson.M{}
+	if !filter.UpdatedFrom.IsZero() {
+		updatedAt["$gte"] = filter.UpdatedFrom
+	}
+	if !filter.UpdatedTo.IsZero() {
+		updatedAt["$lt"] = filter.UpdatedTo
+	}
+	if len(updatedAt) > 0 {
+		match["updated_at"] = updatedAt
+	}
+
+	return match
+}
+
+// priceRangeMatch builds $match of the price range only, it's applied
+// after conversion when prices are listed in another currency.
+func priceRangeMatch(filter models.PriceFilter) bson.M {
+	price := bson.M{}
+	if filter.MinPrice != nil {
+		price["$gte"] = *filter.MinPrice
+	}
+	if filter.MaxPrice != nil {
+		price["$lte"] = *filter.MaxPrice
+	}
+	if len(price) == 0 {
+		return bson.M{}
+	}
+	return bson.M{"price": price}
+}
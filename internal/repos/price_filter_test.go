@@ -0,0 +1,109 @@
+package repos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/roman-wb/price-service/internal/models"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestPriceFilterMatch(t *testing.T) {
+	now := time.Now().UTC()
+	minPrice, maxPrice := models.MustParsePrice("1.5"), models.MustParsePrice("10")
+	minChanges, maxChanges := int64(1), int64(5)
+
+	testCases := []struct {
+		name string
+
+		filter models.PriceFilter
+
+		want bson.M
+	}{
+		{
+			name: "Empty filter",
+
+			filter: models.PriceFilter{},
+
+			want: bson.M{"deleted_at": notDeleted},
+		},
+		{
+			name: "Source",
+
+			filter: models.PriceFilter{Source: "shop1"},
+
+			want: bson.M{"deleted_at": notDeleted, "source": "shop1"},
+		},
+		{
+			name: "Name prefix",
+
+			filter: models.PriceFilter{NamePrefix: "Product (1"},
+
+			want: bson.M{"deleted_at": notDeleted, "name": bson.M{"$regex": `^Product \(1`}},
+		},
+		{
+			name: "Name contains",
+
+			filter: models.PriceFilter{NameContains: "duct"},
+
+			want: bson.M{"deleted_at": notDeleted, "name": bson.M{"$regex": "duct", "$options": "i"}},
+		},
+		{
+			name: "Name prefix and contains",
+
+			filter: models.PriceFilter{NamePrefix: "Pro", NameContains: "1"},
+
+			want: bson.M{"deleted_at": notDeleted, "$and": bson.A{
+				bson.M{"name": bson.M{"$regex": "^Pro"}},
+				bson.M{"name": bson.M{"$regex": "1", "$options": "i"}},
+			}},
+		},
+		{
+			name: "Ranges",
+
+			filter: models.PriceFilter{
+				MinPrice:    &minPrice,
+				MaxPrice:    &maxPrice,
+				MinChanges:  &minChanges,
+				MaxChanges:  &maxChanges,
+				UpdatedFrom: now.Add(-time.Hour),
+				UpdatedTo:   now,
+			},
+
+			want: bson.M{
+				"deleted_at": notDeleted,
+				"price":      bson.M{"$gte": minPrice, "$lte": maxPrice},
+				"changes":    bson.M{"$gte": int64(1), "$lte": int64(5)},
+				"updated_at": bson.M{"$gte": now.Add(-time.Hour), "$lt": now},
+			},
+		},
+		{
+			name: "Open ranges",
+
+			filter: models.PriceFilter{
+				MaxPrice:    &maxPrice,
+				MinChanges:  &minChanges,
+				UpdatedFrom: now,
+			},
+
+			want: bson.M{
+				"deleted_at": notDeleted,
+				"price":      bson.M{"$lte": maxPrice},
+				"changes":    bson.M{"$gte": int64(1)},
+				"updated_at": bson.M{"$gte": now},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := priceFilterMatch(tc.filter)
+
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -2,14 +2,20 @@ package repos
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"time"
 
 	"github.com/roman-wb/price-service/internal/models"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-const PriceCollection = "prices"
+const (
+	PriceCollection   = "prices"
+	HistoryCollection = "price_history"
+)
 
 var orderFields = map[string]struct{}{
 	"name":       {},
@@ -18,35 +24,251 @@ var orderFields = map[string]struct{}{
 	"updated_at": {},
 }
 
+// SourceNameIndex is the unique index used to look up prices by source
+// and name.
+const SourceNameIndex = "source_name_sort_by_asc_unique"
+
+// notDeleted matches prices which aren't deleted by snapshot import.
+var notDeleted = bson.M{"$exists": false}
+
+// DefaultBatchSize is count of prices written by one bulk write.
+const DefaultBatchSize = 1000
+
 type PriceRepo struct {
-	collection *mongo.Collection
+	collection        *mongo.Collection
+	historyCollection *mongo.Collection
+	batchSize         int
 }
 
-func NewPriceRepo(db *mongo.Database) *PriceRepo {
+func NewPriceRepo(db *mongo.Database, batchSize int) *PriceRepo {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
 	return &PriceRepo{
-		collection: db.Collection(PriceCollection),
+		collection:        db.Collection(PriceCollection),
+		historyCollection: db.Collection(HistoryCollection),
+		batchSize:         batchSize,
 	}
 }
 
-func (pr *PriceRepo) Import(updatedAt time.Time, prices []models.Price) error {
-	update := []mongo.WriteModel{}
+// Import reads prices of the source from reader and writes them by
+// batches, so only one batch is kept in memory. Import stops when ctx is
+// done, written batches are kept.
+func (pr *PriceRepo) Import(ctx context.Context, updatedAt time.Time, source string, reader models.PriceReader) (models.ImportStats, error) {
+	var stats models.ImportStats
+
+	batch := make([]models.Price, 0, pr.batchSize)
+	for {
+		if err := ctx.Err(); err != nil {
+			return stats, err
+		}
+
+		price, err := reader.Read()
+		if err != nil && err != io.EOF {
+			return stats, err
+		}
+		if err == nil {
+			price.Source = source
+			batch = append(batch, price)
+		}
+
+		if len(batch) == pr.batchSize || (err == io.EOF && len(batch) > 0) {
+			batchStats, batchErr := pr.importBatch(ctx, updatedAt, batch)
+			stats.Inserted += batchStats.Inserted
+			stats.Updated += batchStats.Updated
+			stats.Unchanged += batchStats.Unchanged
+			if batchErr != nil {
+				return stats, batchErr
+			}
+			batch = batch[:0]
+		}
+
+		if err == io.EOF {
+			return stats, nil
+		}
+	}
+}
+
+// importBatch upserts prices in two passes: the first one updates products
+// whose stored price differs, the second one inserts new products and
+// touches updated_at of the rest. Duplicate names keep the last price.
+func (pr *PriceRepo) importBatch(ctx context.Context, updatedAt time.Time, prices []models.Price) (models.ImportStats, error) {
+	var stats models.ImportStats
+
+	prices = uniquePrices(prices)
+	if len(prices) == 0 {
+		return stats, nil
+	}
+
+	changes := []mongo.WriteModel{}
+	upserts := []mongo.WriteModel{}
+	history := []interface{}{}
 	for _, price := range prices {
-		writeModel := pr.updateModel(updatedAt, price)
-		update = append(update, writeModel)
+		changes = append(changes, pr.changeModel(updatedAt, price))
+		upserts = append(upserts, pr.upsertModel(updatedAt, price))
+		history = append(history, models.PriceHistory{
+			Name:      price.Name,
+			Price:     price.Price,
+			Currency:  price.Currency,
+			Source:    price.Source,
+			CreatedAt: updatedAt,
+		})
+	}
+
+	result, err := pr.collection.BulkWrite(ctx, changes)
+	if err != nil {
+		return stats, err
+	}
+	stats.Updated = int(result.ModifiedCount)
+
+	result, err = pr.collection.BulkWrite(ctx, upserts)
+	if err != nil {
+		return stats, err
+	}
+	stats.Inserted = int(result.UpsertedCount)
+	stats.Unchanged = len(prices) - stats.Inserted - stats.Updated
+
+	_, err = pr.historyCollection.InsertMany(ctx, history)
+	if err != nil {
+		return stats, err
+	}
+
+	return stats, nil
+}
+
+// DeleteMissing marks prices of the source which weren't imported at
+// updatedAt as deleted, so the source keeps only prices of the last
+// snapshot of its feed. Nothing is deleted and models.ErrTooManyDeleted
+// is returned when more than maxPercent of prices would be deleted.
+func (pr *PriceRepo) DeleteMissing(ctx context.Context, updatedAt time.Time, source string, maxPercent int) (int, error) {
+	active := bson.M{"source": source, "deleted_at": notDeleted}
+	total, err := pr.collection.CountDocuments(ctx, active)
+	if err != nil {
+		return 0, err
+	}
+
+	// MongoDB keeps milliseconds, so imported prices are not before it
+	missing := bson.M{
+		"source":     source,
+		"deleted_at": notDeleted,
+		"updated_at": bson.M{"$lt": updatedAt.Truncate(time.Millisecond)},
+	}
+	count, err := pr.collection.CountDocuments(ctx, missing)
+	if err != nil || count == 0 {
+		return 0, err
+	}
+	if count*100 > total*int64(maxPercent) {
+		return 0, fmt.Errorf("%w: %d of %d prices of source %q are missing in feed", models.ErrTooManyDeleted, count, total, source)
+	}
+
+	result, err := pr.collection.UpdateMany(ctx, missing, bson.M{"$set": bson.M{"deleted_at": updatedAt}})
+	if err != nil {
+		return 0, err
 	}
-	_, err := pr.collection.BulkWrite(context.Background(), update)
-	return err
+
+	return int(result.ModifiedCount), nil
+}
+
+func (pr *PriceRepo) List(ctx context.Context, query models.PriceQuery) (models.PricePage, error) {
+	var page models.PricePage
+
+	pipeline, err := pr.listPipeline(&query)
+	if err != nil {
+		return page, err
+	}
+
+	// Best prices are grouped in memory, large lists spill to disk.
+	opts := options.Aggregate().SetAllowDiskUse(true)
+
+	cursor, err := pr.collection.Aggregate(ctx, pipeline, opts)
+	if err != nil {
+		return page, err
+	}
+
+	err = cursor.All(ctx, &page.Prices)
+	if err != nil {
+		return page, err
+	}
+
+	if len(page.Prices) == query.Limit {
+		last := page.Prices[len(page.Prices)-1]
+		page.NextPageToken, err = newPageToken(query, last).encode()
+		if err != nil {
+			return page, err
+		}
+	}
+
+	// Separate count keeps index sort of the list, $facet can't use it.
+	if query.WithTotal {
+		total, err := pr.count(ctx, query)
+		if err != nil {
+			return page, err
+		}
+		page.Total = &total
+	}
+
+	return page, nil
+}
+
+// count returns total of the list, converted and best prices are counted
+// by the same stages as listed ones.
+func (pr *PriceRepo) count(ctx context.Context, query models.PriceQuery) (int64, error) {
+	if query.Currency == "" && !query.BestPrice {
+		return pr.collection.CountDocuments(ctx, priceFilterMatch(query.Filter))
+	}
+
+	pipeline := append(pr.filterStages(query), bson.M{"$count": "total"})
+	opts := options.Aggregate().SetAllowDiskUse(true)
+	cursor, err := pr.collection.Aggregate(ctx, pipeline, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	var result []struct {
+		Total int64 `bson:"total"`
+	}
+	err = cursor.All(ctx, &result)
+	if err != nil || len(result) == 0 {
+		return 0, err
+	}
+
+	return result[0].Total, nil
+}
+
+// Get returns nil when price of the source isn't found.
+func (pr *PriceRepo) Get(ctx context.Context, source string, name string) (*models.Price, error) {
+	opts := options.FindOne().SetHint(SourceNameIndex)
+
+	var price models.Price
+	filter := bson.M{"source": source, "name": name, "deleted_at": notDeleted}
+	err := pr.collection.FindOne(ctx, filter, opts).Decode(&price)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &price, nil
 }
 
-func (pr *PriceRepo) List(skip int, limit int, orderBy string, orderType int32) ([]models.Price, error) {
-	pipeline := pr.listPipeline(skip, limit, orderBy, orderType)
-	cursor, err := pr.collection.Aggregate(context.Background(), pipeline)
+// GetMany returns found prices of the source in any order.
+func (pr *PriceRepo) GetMany(ctx context.Context, source string, names []string) ([]models.Price, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	opts := options.Find().SetHint(SourceNameIndex)
+
+	filter := bson.M{"source": source, "name": bson.M{"$in": names}, "deleted_at": notDeleted}
+	cursor, err := pr.collection.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
 	}
 
 	var prices []models.Price
-	err = cursor.All(context.Background(), &prices)
+	err = cursor.All(ctx, &prices)
 	if err != nil {
 		return nil, err
 	}
@@ -54,42 +276,236 @@ func (pr *PriceRepo) List(skip int, limit int, orderBy string, orderType int32)
 	return prices, nil
 }
 
-func (pr *PriceRepo) updateModel(updatedAt time.Time, price models.Price) *mongo.UpdateOneModel {
+// History returns prices of product of the source by created_at.
+func (pr *PriceRepo) History(ctx context.Context, source string, name string, from time.Time, to time.Time, skip int, limit int) ([]models.PriceHistory, error) {
+	pipeline := pr.historyPipeline(source, name, from, to, skip, limit)
+	cursor, err := pr.historyCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	var history []models.PriceHistory
+	err = cursor.All(ctx, &history)
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+func (pr *PriceRepo) changeModel(updatedAt time.Time, price models.Price) *mongo.UpdateOneModel {
 	return mongo.NewUpdateOneModel().
-		SetFilter(bson.M{"name": price.Name}).
+		SetFilter(bson.M{
+			"source": price.Source,
+			"name":   price.Name,
+			"$or": bson.A{
+				bson.M{"price": bson.M{"$ne": price.Price}},
+				bson.M{"currency": bson.M{"$ne": price.Currency}},
+			},
+		}).
 		SetUpdate(bson.M{
 			"$inc": bson.M{
 				"changes": 1,
 			},
 			"$set": bson.M{
-				"name":       price.Name,
 				"price":      price.Price,
+				"currency":   price.Currency,
 				"updated_at": updatedAt,
 			},
+		})
+}
+
+func (pr *PriceRepo) upsertModel(updatedAt time.Time, price models.Price) *mongo.UpdateOneModel {
+	return mongo.NewUpdateOneModel().
+		SetFilter(bson.M{"source": price.Source, "name": price.Name}).
+		SetUpdate(bson.M{
+			"$set": bson.M{
+				"updated_at": updatedAt,
+			},
+			"$unset": bson.M{
+				"deleted_at": "",
+			},
+			"$setOnInsert": bson.M{
+				"source":   price.Source,
+				"name":     price.Name,
+				"price":    price.Price,
+				"currency": price.Currency,
+				"changes":  0,
+			},
 		}).
 		SetUpsert(true)
 }
 
-func (pr *PriceRepo) listPipeline(skip int, limit int, orderBy string, orderType int32) []bson.M {
-	if skip < 0 {
-		skip = 0
+// listPipeline normalizes query and sorts prices by the order field and
+// _id, so the order is stable for equal values and page tokens.
+func (pr *PriceRepo) listPipeline(query *models.PriceQuery) ([]bson.M, error) {
+	query.Skip, query.Limit = normalizePaging(query.Skip, query.Limit)
+
+	if _, ok := orderFields[query.OrderBy]; !ok {
+		query.OrderBy = "name"
 	}
 
-	if limit <= 0 || limit > 1000 {
-		limit = 100
+	if query.OrderType != -1 {
+		query.OrderType = 1
+	}
+
+	pipeline := pr.filterStages(*query)
+
+	if query.PageToken != "" {
+		token, err := decodePageToken(query.PageToken, *query)
+		if err != nil {
+			return nil, err
+		}
+		pipeline = append(pipeline, bson.M{"$match": token.match()})
+	}
+
+	return append(pipeline,
+		bson.M{"$sort": bson.D{
+			{Key: query.OrderBy, Value: query.OrderType},
+			{Key: "_id", Value: query.OrderType},
+		}},
+		bson.M{"$skip": query.Skip},
+		bson.M{"$limit": query.Limit},
+	), nil
+}
+
+// filterStages matches prices by the filter. Prices listed in another
+// currency are converted by rates and best prices are grouped first, the
+// price range is matched after them.
+func (pr *PriceRepo) filterStages(query models.PriceQuery) []bson.M {
+	stages := []bson.M{}
+
+	filter := query.Filter
+	if query.Currency != "" || query.BestPrice {
+		filter.MinPrice, filter.MaxPrice = nil, nil
 	}
 
-	if _, ok := orderFields[orderBy]; !ok {
-		orderBy = "name"
+	match := priceFilterMatch(filter)
+	if len(match) > 0 {
+		stages = append(stages, bson.M{"$match": match})
 	}
 
-	if orderType != -1 {
-		orderType = 1
+	if query.Currency == "" && !query.BestPrice {
+		return stages
+	}
+
+	if query.Currency != "" {
+		stages = append(stages, convertStages(query.Currency)...)
+	}
+
+	if query.BestPrice {
+		stages = append(stages, bestPriceStages()...)
+	}
+
+	match = priceRangeMatch(query.Filter)
+	if len(match) > 0 {
+		stages = append(stages, bson.M{"$match": match})
+	}
+
+	return stages
+}
+
+// convertStages converts prices to the currency as price * to / from,
+// rounded to cents. Prices in the currency keep their value and prices
+// without rate of their currency are dropped.
+func convertStages(currency string) []bson.M {
+	return []bson.M{
+		{"$lookup": bson.M{
+			"from":         RateCollection,
+			"localField":   "currency",
+			"foreignField": "currency",
+			"as":           "rate_from",
+		}},
+		{"$lookup": bson.M{
+			"from":     RateCollection,
+			"pipeline": bson.A{bson.M{"$match": bson.M{"currency": currency}}},
+			"as":       "rate_to",
+		}},
+		{"$unwind": "$rate_from"},
+		{"$unwind": "$rate_to"},
+		{"$set": bson.M{
+			"price": bson.M{"$cond": bson.A{
+				bson.M{"$eq": bson.A{"$currency", currency}},
+				"$price",
+				bson.M{"$round": bson.A{
+					bson.M{"$divide": bson.A{
+						bson.M{"$multiply": bson.A{"$price", "$rate_to.rate"}},
+						"$rate_from.rate",
+					}},
+					2,
+				}},
+			}},
+			"currency": currency,
+		}},
+		{"$unset": bson.A{"rate_from", "rate_to"}},
+	}
+}
+
+// bestPriceStages keeps the lowest price of every name, equal prices are
+// chosen by _id to keep pages stable.
+func bestPriceStages() []bson.M {
+	return []bson.M{
+		{"$sort": bson.D{
+			{Key: "name", Value: 1},
+			{Key: "price", Value: 1},
+			{Key: "_id", Value: 1},
+		}},
+		{"$group": bson.M{
+			"_id":   "$name",
+			"price": bson.M{"$first": "$$ROOT"},
+		}},
+		{"$replaceRoot": bson.M{"newRoot": "$price"}},
+	}
+}
+
+func (pr *PriceRepo) historyPipeline(source string, name string, from time.Time, to time.Time, skip int, limit int) []bson.M {
+	skip, limit = normalizePaging(skip, limit)
+
+	createdAt := bson.M{}
+	if !from.IsZero() {
+		createdAt["$gte"] = from
+	}
+	if !to.IsZero() {
+		createdAt["$lt"] = to
+	}
+
+	match := bson.M{"source": source, "name": name}
+	if len(createdAt) > 0 {
+		match["created_at"] = createdAt
 	}
 
 	return []bson.M{
-		{"$sort": bson.D{{Key: orderBy, Value: orderType}}},
+		{"$match": match},
+		{"$sort": bson.D{{Key: "created_at", Value: 1}}},
 		{"$skip": skip},
 		{"$limit": limit},
 	}
 }
+
+func normalizePaging(skip int, limit int) (int, int) {
+	if skip < 0 {
+		skip = 0
+	}
+
+	if limit <= 0 || limit > 1000 {
+		limit = 100
+	}
+
+	return skip, limit
+}
+
+// uniquePrices keeps the last price of every name, prices of a batch
+// have the same source.
+func uniquePrices(prices []models.Price) []models.Price {
+	index := make(map[string]int, len(prices))
+	unique := make([]models.Price, 0, len(prices))
+	for _, price := range prices {
+		if i, ok := index[price.Name]; ok {
+			unique[i] = price
+			continue
+		}
+		index[price.Name] = len(unique)
+		unique = append(unique, price)
+	}
+	return unique
+}
@@ -2,6 +2,8 @@ package repos_test
 
 import (
 	"context"
+	"errors"
+	"io"
 	"testing"
 	"time"
 
@@ -21,14 +23,18 @@ const (
 type PriceRepoTestSuite struct {
 	suite.Suite
 
-	client     *mongo.Client
-	db         *mongo.Database
-	collection *mongo.Collection
+	client            *mongo.Client
+	db                *mongo.Database
+	collection        *mongo.Collection
+	historyCollection *mongo.Collection
 }
 
 func (suite *PriceRepoTestSuite) ClearCollection() {
 	_, err := suite.collection.DeleteMany(context.Background(), bson.M{}, nil)
 	suite.Require().Nil(err)
+
+	_, err = suite.historyCollection.DeleteMany(context.Background(), bson.M{}, nil)
+	suite.Require().Nil(err)
 }
 
 func (suite *PriceRepoTestSuite) SetupTest() {
@@ -38,6 +44,7 @@ func (suite *PriceRepoTestSuite) SetupTest() {
 	suite.client = client
 	suite.db = suite.client.Database(MongoDB)
 	suite.collection = suite.db.Collection(repos.PriceCollection)
+	suite.historyCollection = suite.db.Collection(repos.HistoryCollection)
 
 	suite.ClearCollection()
 }
@@ -51,6 +58,27 @@ func (suite *PriceRepoTestSuite) TearDownAllSuite() {
 	suite.Require().Nil(err)
 }
 
+type sliceReader struct {
+	prices []models.Price
+	read   int
+}
+
+func (r *sliceReader) Read() (models.Price, error) {
+	if r.read == len(r.prices) {
+		return models.Price{}, io.EOF
+	}
+	r.read++
+	return r.prices[r.read-1], nil
+}
+
+func (r *sliceReader) Stats() models.ImportStats {
+	return models.ImportStats{Parsed: r.read}
+}
+
+func (r *sliceReader) Close() error {
+	return nil
+}
+
 func TestPriceRepo(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -62,7 +90,7 @@ func TestPriceRepo(t *testing.T) {
 func (suite *PriceRepoTestSuite) TestImport() {
 	now1 := time.Now().UTC().Truncate(10 * time.Hour)
 	now2 := time.Now().UTC()
-	repo := repos.NewPriceRepo(suite.db)
+	repo := repos.NewPriceRepo(suite.db, 0)
 
 	testCases := []struct {
 		name string
@@ -71,43 +99,89 @@ func (suite *PriceRepoTestSuite) TestImport() {
 		oldPrices []models.Price
 		newPrices []models.Price
 
-		wantLen    int
-		wantPrices []models.Price
+		wantStats   models.ImportStats
+		wantLen     int
+		wantPrices  []models.Price
+		wantHistory int64
 	}{
 		{
 			name: "Insert to empty collection",
 
 			now: now1,
 			newPrices: []models.Price{
-				{Name: "Product 1", Price: 0},
-				{Name: "Product 2", Price: 100.99},
+				{Name: "Product 1", Price: models.MustParsePrice("0")},
+				{Name: "Product 2", Price: models.MustParsePrice("100.99")},
 			},
 
-			wantLen: 2,
+			wantStats: models.ImportStats{Inserted: 2},
+			wantLen:   2,
 			wantPrices: []models.Price{
-				{Name: "Product 1", Price: 0, Changes: 1, UpdatedAt: now1},
-				{Name: "Product 2", Price: 100.99, Changes: 1, UpdatedAt: now1},
+				{Name: "Product 1", Price: models.MustParsePrice("0"), Changes: 0, UpdatedAt: now1},
+				{Name: "Product 2", Price: models.MustParsePrice("100.99"), Changes: 0, UpdatedAt: now1},
 			},
+			wantHistory: 2,
 		},
 		{
 			name: "Insert and update collection",
 
 			now: now2,
 			oldPrices: []models.Price{
-				{Name: "Product 1", Price: 0, Changes: 1, UpdatedAt: now2},
-				{Name: "Product 2", Price: 100.99, Changes: 1, UpdatedAt: now2},
+				{Name: "Product 1", Price: models.MustParsePrice("0"), Changes: 1, UpdatedAt: now1},
+				{Name: "Product 2", Price: models.MustParsePrice("100.99"), Changes: 1, UpdatedAt: now1},
+			},
+			newPrices: []models.Price{
+				{Name: "Product 1", Price: models.MustParsePrice("99")},
+				{Name: "Product 3", Price: models.MustParsePrice("5000")},
+			},
+
+			wantStats: models.ImportStats{Inserted: 1, Updated: 1},
+			wantLen:   3,
+			wantPrices: []models.Price{
+				{Name: "Product 1", Price: models.MustParsePrice("99"), Changes: 2, UpdatedAt: now2},
+				{Name: "Product 2", Price: models.MustParsePrice("100.99"), Changes: 1, UpdatedAt: now1},
+				{Name: "Product 3", Price: models.MustParsePrice("5000"), Changes: 0, UpdatedAt: now2},
+			},
+			wantHistory: 2,
+		},
+		{
+			name: "Same prices are not counted as changes",
+
+			now: now2,
+			oldPrices: []models.Price{
+				{Name: "Product 1", Price: models.MustParsePrice("0"), Changes: 1, UpdatedAt: now1},
+				{Name: "Product 2", Price: models.MustParsePrice("100.99"), Changes: 1, UpdatedAt: now1},
+			},
+			newPrices: []models.Price{
+				{Name: "Product 1", Price: models.MustParsePrice("0")},
+				{Name: "Product 2", Price: models.MustParsePrice("100.99")},
+			},
+
+			wantStats: models.ImportStats{Unchanged: 2},
+			wantLen:   2,
+			wantPrices: []models.Price{
+				{Name: "Product 1", Price: models.MustParsePrice("0"), Changes: 1, UpdatedAt: now2},
+				{Name: "Product 2", Price: models.MustParsePrice("100.99"), Changes: 1, UpdatedAt: now2},
+			},
+			wantHistory: 2,
+		},
+		{
+			name: "Duplicate names keep last price",
+
+			now: now2,
+			oldPrices: []models.Price{
+				{Name: "Product 1", Price: models.MustParsePrice("0"), Changes: 1, UpdatedAt: now1},
 			},
 			newPrices: []models.Price{
-				{Name: "Product 1", Price: 99},
-				{Name: "Product 3", Price: 5000},
+				{Name: "Product 1", Price: models.MustParsePrice("5")},
+				{Name: "Product 1", Price: models.MustParsePrice("10")},
 			},
 
-			wantLen: 3,
+			wantStats: models.ImportStats{Updated: 1},
+			wantLen:   1,
 			wantPrices: []models.Price{
-				{Name: "Product 1", Price: 99, Changes: 2, UpdatedAt: now2},
-				{Name: "Product 2", Price: 100.99, Changes: 1, UpdatedAt: now2},
-				{Name: "Product 3", Price: 5000, Changes: 1, UpdatedAt: now2},
+				{Name: "Product 1", Price: models.MustParsePrice("10"), Changes: 2, UpdatedAt: now2},
 			},
+			wantHistory: 1,
 		},
 	}
 
@@ -121,8 +195,9 @@ func (suite *PriceRepoTestSuite) TestImport() {
 				suite.Require().Nil(err)
 			}
 
-			err := repo.Import(tc.now, tc.newPrices)
+			gotStats, err := repo.Import(context.Background(), tc.now, "", &sliceReader{prices: tc.newPrices})
 			suite.Require().Nil(err)
+			suite.Require().Equal(tc.wantStats, gotStats)
 
 			cursor, err := suite.collection.Find(context.Background(), bson.M{}, nil)
 			suite.Require().Nil(err)
@@ -141,14 +216,93 @@ func (suite *PriceRepoTestSuite) TestImport() {
 				gotDate := gotPrices[i].UpdatedAt.Truncate(time.Second)
 				suite.Require().Equal(wantDate, gotDate)
 			}
+
+			historyCount, err := suite.historyCollection.CountDocuments(context.Background(), bson.M{}, nil)
+			suite.Require().Nil(err)
+			suite.Require().Equal(tc.wantHistory, historyCount)
 		})
 	}
 }
 
+func (suite *PriceRepoTestSuite) TestImportBatches() {
+	now := time.Now().UTC()
+	repo := repos.NewPriceRepo(suite.db, 2)
+
+	prices := []models.Price{
+		{Name: "Product 1", Price: models.MustParsePrice("1")},
+		{Name: "Product 2", Price: models.MustParsePrice("2")},
+		{Name: "Product 3", Price: models.MustParsePrice("3")},
+		{Name: "Product 4", Price: models.MustParsePrice("4")},
+		{Name: "Product 5", Price: models.MustParsePrice("5")},
+	}
+
+	suite.ClearCollection()
+
+	gotStats, err := repo.Import(context.Background(), now, "", &sliceReader{prices: prices})
+	suite.Require().Nil(err)
+	suite.Require().Equal(models.ImportStats{Inserted: 5}, gotStats)
+
+	count, err := suite.collection.CountDocuments(context.Background(), bson.M{}, nil)
+	suite.Require().Nil(err)
+	suite.Require().Equal(int64(5), count)
+
+	prices[4].Price = models.MustParsePrice("50")
+	gotStats, err = repo.Import(context.Background(), now, "", &sliceReader{prices: prices})
+	suite.Require().Nil(err)
+	suite.Require().Equal(models.ImportStats{Updated: 1, Unchanged: 4}, gotStats)
+}
+
+// cancelReader cancels import after count of read prices.
+type cancelReader struct {
+	sliceReader
+
+	after  int
+	cancel context.CancelFunc
+}
+
+func (r *cancelReader) Read() (models.Price, error) {
+	if r.read == r.after {
+		r.cancel()
+	}
+	return r.sliceReader.Read()
+}
+
+func (suite *PriceRepoTestSuite) TestImportCancel() {
+	now := time.Now().UTC()
+	repo := repos.NewPriceRepo(suite.db, 2)
+
+	prices := []models.Price{
+		{Name: "Product 1", Price: models.MustParsePrice("1")},
+		{Name: "Product 2", Price: models.MustParsePrice("2")},
+		{Name: "Product 3", Price: models.MustParsePrice("3")},
+		{Name: "Product 4", Price: models.MustParsePrice("4")},
+	}
+
+	suite.ClearCollection()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reader := &cancelReader{sliceReader: sliceReader{prices: prices}, after: 2, cancel: cancel}
+	gotStats, err := repo.Import(ctx, now, "", reader)
+	suite.Require().Equal(context.Canceled, err)
+	suite.Require().Equal(models.ImportStats{Inserted: 2}, gotStats)
+
+	count, err := suite.collection.CountDocuments(context.Background(), bson.M{}, nil)
+	suite.Require().Nil(err)
+	suite.Require().Equal(int64(2), count)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+
+	_, err = repo.List(ctx, models.PriceQuery{Limit: 10})
+	suite.Require().True(errors.Is(err, context.Canceled), err)
+}
+
 func (suite *PriceRepoTestSuite) TestList() {
 	now1 := time.Now().UTC().Truncate(10 * time.Hour)
 	now2 := time.Now().UTC()
-	repo := repos.NewPriceRepo(suite.db)
+	repo := repos.NewPriceRepo(suite.db, 0)
 
 	testCases := []struct {
 		name string
@@ -174,13 +328,13 @@ func (suite *PriceRepoTestSuite) TestList() {
 
 			skip: -1,
 			prices: []models.Price{
-				{Name: "Product 1", Price: 0, Changes: 1, UpdatedAt: now1},
-				{Name: "Product 2", Price: 100.99, Changes: 1, UpdatedAt: now2},
+				{Name: "Product 1", Price: models.MustParsePrice("0"), Changes: 1, UpdatedAt: now1},
+				{Name: "Product 2", Price: models.MustParsePrice("100.99"), Changes: 1, UpdatedAt: now2},
 			},
 
 			wantPrices: []models.Price{
-				{Name: "Product 1", Price: 0, Changes: 1, UpdatedAt: now1},
-				{Name: "Product 2", Price: 100.99, Changes: 1, UpdatedAt: now2},
+				{Name: "Product 1", Price: models.MustParsePrice("0"), Changes: 1, UpdatedAt: now1},
+				{Name: "Product 2", Price: models.MustParsePrice("100.99"), Changes: 1, UpdatedAt: now2},
 			},
 			wantErr: nil,
 		},
@@ -189,13 +343,13 @@ func (suite *PriceRepoTestSuite) TestList() {
 
 			skip: 0,
 			prices: []models.Price{
-				{Name: "Product 1", Price: 0, Changes: 1, UpdatedAt: now1},
-				{Name: "Product 2", Price: 100.99, Changes: 1, UpdatedAt: now2},
+				{Name: "Product 1", Price: models.MustParsePrice("0"), Changes: 1, UpdatedAt: now1},
+				{Name: "Product 2", Price: models.MustParsePrice("100.99"), Changes: 1, UpdatedAt: now2},
 			},
 
 			wantPrices: []models.Price{
-				{Name: "Product 1", Price: 0, Changes: 1, UpdatedAt: now1},
-				{Name: "Product 2", Price: 100.99, Changes: 1, UpdatedAt: now2},
+				{Name: "Product 1", Price: models.MustParsePrice("0"), Changes: 1, UpdatedAt: now1},
+				{Name: "Product 2", Price: models.MustParsePrice("100.99"), Changes: 1, UpdatedAt: now2},
 			},
 			wantErr: nil,
 		},
@@ -204,12 +358,12 @@ func (suite *PriceRepoTestSuite) TestList() {
 
 			skip: 1,
 			prices: []models.Price{
-				{Name: "Product 1", Price: 0, Changes: 1, UpdatedAt: now1},
-				{Name: "Product 2", Price: 100.99, Changes: 1, UpdatedAt: now2},
+				{Name: "Product 1", Price: models.MustParsePrice("0"), Changes: 1, UpdatedAt: now1},
+				{Name: "Product 2", Price: models.MustParsePrice("100.99"), Changes: 1, UpdatedAt: now2},
 			},
 
 			wantPrices: []models.Price{
-				{Name: "Product 2", Price: 100.99, Changes: 1, UpdatedAt: now2},
+				{Name: "Product 2", Price: models.MustParsePrice("100.99"), Changes: 1, UpdatedAt: now2},
 			},
 			wantErr: nil,
 		},
@@ -218,13 +372,13 @@ func (suite *PriceRepoTestSuite) TestList() {
 
 			limit: 0,
 			prices: []models.Price{
-				{Name: "Product 1", Price: 0, Changes: 1, UpdatedAt: now1},
-				{Name: "Product 2", Price: 100.99, Changes: 1, UpdatedAt: now2},
+				{Name: "Product 1", Price: models.MustParsePrice("0"), Changes: 1, UpdatedAt: now1},
+				{Name: "Product 2", Price: models.MustParsePrice("100.99"), Changes: 1, UpdatedAt: now2},
 			},
 
 			wantPrices: []models.Price{
-				{Name: "Product 1", Price: 0, Changes: 1, UpdatedAt: now1},
-				{Name: "Product 2", Price: 100.99, Changes: 1, UpdatedAt: now2},
+				{Name: "Product 1", Price: models.MustParsePrice("0"), Changes: 1, UpdatedAt: now1},
+				{Name: "Product 2", Price: models.MustParsePrice("100.99"), Changes: 1, UpdatedAt: now2},
 			},
 			wantErr: nil,
 		},
@@ -233,12 +387,12 @@ func (suite *PriceRepoTestSuite) TestList() {
 
 			limit: 1,
 			prices: []models.Price{
-				{Name: "Product 1", Price: 0, Changes: 1, UpdatedAt: now1},
-				{Name: "Product 2", Price: 100.99, Changes: 1, UpdatedAt: now2},
+				{Name: "Product 1", Price: models.MustParsePrice("0"), Changes: 1, UpdatedAt: now1},
+				{Name: "Product 2", Price: models.MustParsePrice("100.99"), Changes: 1, UpdatedAt: now2},
 			},
 
 			wantPrices: []models.Price{
-				{Name: "Product 1", Price: 0, Changes: 1, UpdatedAt: now1},
+				{Name: "Product 1", Price: models.MustParsePrice("0"), Changes: 1, UpdatedAt: now1},
 			},
 			wantErr: nil,
 		},
@@ -248,13 +402,13 @@ func (suite *PriceRepoTestSuite) TestList() {
 			orderBy:   "name",
 			orderType: 1,
 			prices: []models.Price{
-				{Name: "Product 2", Price: 0, Changes: 1, UpdatedAt: now2},
-				{Name: "Product 1", Price: 100.99, Changes: 2, UpdatedAt: now1},
+				{Name: "Product 2", Price: models.MustParsePrice("0"), Changes: 1, UpdatedAt: now2},
+				{Name: "Product 1", Price: models.MustParsePrice("100.99"), Changes: 2, UpdatedAt: now1},
 			},
 
 			wantPrices: []models.Price{
-				{Name: "Product 1", Price: 100.99, Changes: 2, UpdatedAt: now1},
-				{Name: "Product 2", Price: 0, Changes: 1, UpdatedAt: now2},
+				{Name: "Product 1", Price: models.MustParsePrice("100.99"), Changes: 2, UpdatedAt: now1},
+				{Name: "Product 2", Price: models.MustParsePrice("0"), Changes: 1, UpdatedAt: now2},
 			},
 			wantErr: nil,
 		},
@@ -264,13 +418,13 @@ func (suite *PriceRepoTestSuite) TestList() {
 			orderBy:   "name",
 			orderType: -1,
 			prices: []models.Price{
-				{Name: "Product 1", Price: 100.99, Changes: 2, UpdatedAt: now1},
-				{Name: "Product 2", Price: 0, Changes: 1, UpdatedAt: now2},
+				{Name: "Product 1", Price: models.MustParsePrice("100.99"), Changes: 2, UpdatedAt: now1},
+				{Name: "Product 2", Price: models.MustParsePrice("0"), Changes: 1, UpdatedAt: now2},
 			},
 
 			wantPrices: []models.Price{
-				{Name: "Product 2", Price: 0, Changes: 1, UpdatedAt: now2},
-				{Name: "Product 1", Price: 100.99, Changes: 2, UpdatedAt: now1},
+				{Name: "Product 2", Price: models.MustParsePrice("0"), Changes: 1, UpdatedAt: now2},
+				{Name: "Product 1", Price: models.MustParsePrice("100.99"), Changes: 2, UpdatedAt: now1},
 			},
 			wantErr: nil,
 		},
@@ -286,7 +440,13 @@ func (suite *PriceRepoTestSuite) TestList() {
 				suite.Require().Nil(err)
 			}
 
-			gotPrices, gotErr := repo.List(tc.skip, tc.limit, tc.orderBy, tc.orderType)
+			gotPage, gotErr := repo.List(context.Background(), models.PriceQuery{
+				Skip:      tc.skip,
+				Limit:     tc.limit,
+				OrderBy:   tc.orderBy,
+				OrderType: tc.orderType,
+			})
+			gotPrices := gotPage.Prices
 
 			suite.Require().Equal(len(tc.wantPrices), len(gotPrices))
 			for i := range tc.wantPrices {
@@ -301,3 +461,491 @@ func (suite *PriceRepoTestSuite) TestList() {
 		})
 	}
 }
+
+func (suite *PriceRepoTestSuite) TestGet() {
+	now := time.Now().UTC()
+	repo := repos.NewPriceRepo(suite.db, 0)
+
+	suite.ClearCollection()
+	for _, price := range []models.Price{
+		{Name: "Product 1", Price: models.MustParsePrice("10"), Changes: 1, UpdatedAt: now},
+		{Name: "Product 2", Price: models.MustParsePrice("20"), Changes: 2, UpdatedAt: now},
+	} {
+		_, err := suite.collection.InsertOne(context.Background(), price)
+		suite.Require().Nil(err)
+	}
+
+	gotPrice, err := repo.Get(context.Background(), "", "Product 2")
+	suite.Require().Nil(err)
+	suite.Require().Equal("Product 2", gotPrice.Name)
+	suite.Require().Equal(models.MustParsePrice("20"), gotPrice.Price)
+	suite.Require().Equal(2, gotPrice.Changes)
+
+	gotPrice, err = repo.Get(context.Background(), "", "Product 3")
+	suite.Require().Nil(err)
+	suite.Require().Nil(gotPrice)
+
+	gotPrices, err := repo.GetMany(context.Background(), "", []string{"Product 1", "Product 2", "Product 3"})
+	suite.Require().Nil(err)
+	suite.Require().Equal(2, len(gotPrices))
+
+	gotPrices, err = repo.GetMany(context.Background(), "", nil)
+	suite.Require().Nil(err)
+	suite.Require().Equal(0, len(gotPrices))
+}
+
+func (suite *PriceRepoTestSuite) TestHistory() {
+	now := time.Now().UTC()
+	hourAgo := now.Add(-time.Hour)
+	dayAgo := now.Add(-24 * time.Hour)
+	repo := repos.NewPriceRepo(suite.db, 0)
+
+	history := []models.PriceHistory{
+		{Name: "Product 1", Price: models.MustParsePrice("10"), CreatedAt: dayAgo},
+		{Name: "Product 1", Price: models.MustParsePrice("20"), CreatedAt: hourAgo},
+		{Name: "Product 1", Price: models.MustParsePrice("30"), CreatedAt: now},
+		{Name: "Product 2", Price: models.MustParsePrice("100.99"), CreatedAt: now},
+		{Name: "Product 1", Source: "shop1", Price: models.MustParsePrice("15"), CreatedAt: hourAgo},
+	}
+
+	testCases := []struct {
+		name string
+
+		source      string
+		productName string
+		from        time.Time
+		to          time.Time
+		skip        int
+		limit       int
+
+		wantHistory []models.PriceHistory
+		wantErr     error
+	}{
+		{
+			name: "Return empty result",
+
+			productName: "Product 3",
+
+			wantHistory: nil,
+			wantErr:     nil,
+		},
+		{
+			name: "Return all for product",
+
+			productName: "Product 1",
+
+			wantHistory: []models.PriceHistory{
+				{Name: "Product 1", Price: models.MustParsePrice("10"), CreatedAt: dayAgo},
+				{Name: "Product 1", Price: models.MustParsePrice("20"), CreatedAt: hourAgo},
+				{Name: "Product 1", Price: models.MustParsePrice("30"), CreatedAt: now},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Return all for product of source",
+
+			source:      "shop1",
+			productName: "Product 1",
+
+			wantHistory: []models.PriceHistory{
+				{Name: "Product 1", Source: "shop1", Price: models.MustParsePrice("15"), CreatedAt: hourAgo},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Return range",
+
+			productName: "Product 1",
+			from:        hourAgo,
+			to:          now,
+
+			wantHistory: []models.PriceHistory{
+				{Name: "Product 1", Price: models.MustParsePrice("20"), CreatedAt: hourAgo},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Return page",
+
+			productName: "Product 1",
+			skip:        1,
+			limit:       1,
+
+			wantHistory: []models.PriceHistory{
+				{Name: "Product 1", Price: models.MustParsePrice("20"), CreatedAt: hourAgo},
+			},
+			wantErr: nil,
+		},
+	}
+
+	suite.ClearCollection()
+	for _, entry := range history {
+		_, err := suite.historyCollection.InsertOne(context.Background(), entry)
+		suite.Require().Nil(err)
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			gotHistory, gotErr := repo.History(context.Background(), tc.source, tc.productName, tc.from, tc.to, tc.skip, tc.limit)
+
+			suite.Require().Equal(len(tc.wantHistory), len(gotHistory))
+			for i := range tc.wantHistory {
+				suite.Require().Equal(tc.wantHistory[i].Name, gotHistory[i].Name)
+				suite.Require().Equal(tc.wantHistory[i].Source, gotHistory[i].Source)
+				suite.Require().Equal(tc.wantHistory[i].Price, gotHistory[i].Price)
+				wantDate := tc.wantHistory[i].CreatedAt.Truncate(time.Millisecond)
+				gotDate := gotHistory[i].CreatedAt.Truncate(time.Millisecond)
+				suite.Require().Equal(wantDate, gotDate)
+			}
+			suite.Require().Equal(tc.wantErr, gotErr)
+		})
+	}
+}
+
+func (suite *PriceRepoTestSuite) TestListPageToken() {
+	now := time.Now().UTC()
+	repo := repos.NewPriceRepo(suite.db, 0)
+
+	suite.ClearCollection()
+	for _, price := range []models.Price{
+		{Name: "Product 1", Price: models.MustParsePrice("10"), Changes: 1, UpdatedAt: now},
+		{Name: "Product 2", Price: models.MustParsePrice("30"), Changes: 1, UpdatedAt: now},
+		{Name: "Product 3", Price: models.MustParsePrice("20"), Changes: 1, UpdatedAt: now},
+		{Name: "Product 4", Price: models.MustParsePrice("20"), Changes: 1, UpdatedAt: now},
+	} {
+		_, err := suite.collection.InsertOne(context.Background(), price)
+		suite.Require().Nil(err)
+	}
+
+	readNames := func(orderBy string, orderType int32, insert *models.Price) []string {
+		var names []string
+		query := models.PriceQuery{Limit: 1, OrderBy: orderBy, OrderType: orderType}
+		for {
+			page, err := repo.List(context.Background(), query)
+			suite.Require().Nil(err)
+			for _, price := range page.Prices {
+				names = append(names, price.Name)
+			}
+			if page.NextPageToken == "" {
+				return names
+			}
+			query.PageToken = page.NextPageToken
+
+			if insert != nil {
+				_, err = suite.collection.InsertOne(context.Background(), *insert)
+				suite.Require().Nil(err)
+				insert = nil
+			}
+		}
+	}
+
+	suite.Require().Equal(
+		[]string{"Product 1", "Product 3", "Product 4", "Product 2"},
+		readNames("price", 1, nil),
+	)
+	suite.Require().Equal(
+		[]string{"Product 2", "Product 4", "Product 3", "Product 1"},
+		readNames("price", -1, nil),
+	)
+	suite.Require().Equal(
+		[]string{"Product 1", "Product 2", "Product 3", "Product 4"},
+		readNames("changes", 1, nil),
+	)
+	suite.Require().Equal(
+		[]string{"Product 1", "Product 2", "Product 3", "Product 4"},
+		readNames("name", 1, &models.Price{Name: "Product 0", Price: models.MustParsePrice("0"), UpdatedAt: now}),
+	)
+
+	_, err := repo.List(context.Background(), models.PriceQuery{PageToken: "invalid"})
+	suite.Require().Equal(models.ErrInvalidPageToken, err)
+}
+
+func (suite *PriceRepoTestSuite) TestListFilter() {
+	now := time.Now().UTC()
+	hourAgo := now.Add(-time.Hour)
+	repo := repos.NewPriceRepo(suite.db, 0)
+
+	suite.ClearCollection()
+	for _, price := range []models.Price{
+		{Name: "Apple", Price: models.MustParsePrice("5"), Changes: 0, UpdatedAt: hourAgo},
+		{Name: "Apple juice", Price: models.MustParsePrice("15"), Changes: 2, UpdatedAt: now},
+		{Name: "Pineapple", Price: models.MustParsePrice("9.99"), Changes: 5, UpdatedAt: now},
+		{Name: "Banana", Price: models.MustParsePrice("20"), Changes: 1, UpdatedAt: hourAgo},
+	} {
+		_, err := suite.collection.InsertOne(context.Background(), price)
+		suite.Require().Nil(err)
+	}
+
+	maxPrice := models.MustParsePrice("10")
+	minPrice := models.MustParsePrice("10")
+	minChanges := int64(1)
+	maxChanges := int64(2)
+
+	testCases := []struct {
+		name string
+
+		filter models.PriceFilter
+
+		wantNames []string
+	}{
+		{
+			name: "Name prefix",
+
+			filter: models.PriceFilter{NamePrefix: "Apple"},
+
+			wantNames: []string{"Apple", "Apple juice"},
+		},
+		{
+			name: "Name contains",
+
+			filter: models.PriceFilter{NameContains: "apple"},
+
+			wantNames: []string{"Apple", "Apple juice", "Pineapple"},
+		},
+		{
+			name: "Price under 10",
+
+			filter: models.PriceFilter{MaxPrice: &maxPrice},
+
+			wantNames: []string{"Apple", "Pineapple"},
+		},
+		{
+			name: "Price from 10 and changes range",
+
+			filter: models.PriceFilter{MinPrice: &minPrice, MinChanges: &minChanges, MaxChanges: &maxChanges},
+
+			wantNames: []string{"Apple juice", "Banana"},
+		},
+		{
+			name: "Updated in last 30 minutes",
+
+			filter: models.PriceFilter{UpdatedFrom: now.Add(-30 * time.Minute)},
+
+			wantNames: []string{"Apple juice", "Pineapple"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			gotPage, err := repo.List(context.Background(), models.PriceQuery{Filter: tc.filter, Limit: 1, WithTotal: true})
+			suite.Require().Nil(err)
+			suite.Require().Equal(int64(len(tc.wantNames)), *gotPage.Total)
+
+			gotPage, err = repo.List(context.Background(), models.PriceQuery{Filter: tc.filter})
+			suite.Require().Nil(err)
+			suite.Require().Nil(gotPage.Total)
+
+			var gotNames []string
+			for _, price := range gotPage.Prices {
+				gotNames = append(gotNames, price.Name)
+			}
+			suite.Require().Equal(tc.wantNames, gotNames)
+		})
+	}
+}
+
+func (suite *PriceRepoTestSuite) TestListCurrency() {
+	now := time.Now().UTC()
+	repo := repos.NewPriceRepo(suite.db, 0)
+	rateRepo := repos.NewRateRepo(suite.db)
+
+	suite.ClearCollection()
+	for _, price := range []models.Price{
+		{Name: "Product 1", Price: models.MustParsePrice("10.50"), Currency: "USD", UpdatedAt: now},
+		{Name: "Product 2", Price: models.MustParsePrice("10"), Currency: "EUR", UpdatedAt: now},
+		{Name: "Product 3", Price: models.MustParsePrice("100"), Currency: "RUB", UpdatedAt: now},
+		{Name: "Product 4", Price: models.MustParsePrice("1"), UpdatedAt: now},
+	} {
+		_, err := suite.collection.InsertOne(context.Background(), price)
+		suite.Require().Nil(err)
+	}
+
+	err := rateRepo.Set(context.Background(), now, []models.Rate{
+		{Currency: "USD", Rate: models.MustParsePrice("1")},
+		{Currency: "EUR", Rate: models.MustParsePrice("0.8")},
+	})
+	suite.Require().Nil(err)
+
+	maxPrice := models.MustParsePrice("12")
+	page, err := repo.List(context.Background(), models.PriceQuery{
+		Currency:  "USD",
+		OrderBy:   "price",
+		Filter:    models.PriceFilter{MaxPrice: &maxPrice},
+		WithTotal: true,
+	})
+	suite.Require().Nil(err)
+	suite.Require().Equal(int64(1), *page.Total)
+	suite.Require().Len(page.Prices, 1)
+	suite.Require().Equal("Product 1", page.Prices[0].Name)
+	suite.Require().Equal(models.MustParsePrice("10.50"), page.Prices[0].Price)
+
+	page, err = repo.List(context.Background(), models.PriceQuery{Currency: "EUR", OrderBy: "price", Limit: 1})
+	suite.Require().Nil(err)
+	suite.Require().Len(page.Prices, 1)
+	suite.Require().Equal("Product 1", page.Prices[0].Name)
+	suite.Require().Equal("EUR", page.Prices[0].Currency)
+	suite.Require().Equal(models.MustParsePrice("8.40"), page.Prices[0].Price)
+
+	_, err = repo.List(context.Background(), models.PriceQuery{Currency: "USD", OrderBy: "price", Limit: 1, PageToken: page.NextPageToken})
+	suite.Require().Equal(models.ErrInvalidPageToken, err)
+
+	page, err = repo.List(context.Background(), models.PriceQuery{Currency: "EUR", OrderBy: "price", Limit: 1, PageToken: page.NextPageToken})
+	suite.Require().Nil(err)
+	suite.Require().Len(page.Prices, 1)
+	suite.Require().Equal("Product 2", page.Prices[0].Name)
+	suite.Require().Equal(models.MustParsePrice("10"), page.Prices[0].Price)
+}
+
+func (suite *PriceRepoTestSuite) TestImportSources() {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	repo := repos.NewPriceRepo(suite.db, 0)
+
+	suite.ClearCollection()
+
+	gotStats, err := repo.Import(context.Background(), now, "shop1", &sliceReader{prices: []models.Price{
+		{Name: "Product 1", Price: models.MustParsePrice("10")},
+	}})
+	suite.Require().Nil(err)
+	suite.Require().Equal(models.ImportStats{Inserted: 1}, gotStats)
+
+	gotStats, err = repo.Import(context.Background(), now, "shop2", &sliceReader{prices: []models.Price{
+		{Name: "Product 1", Price: models.MustParsePrice("12")},
+	}})
+	suite.Require().Nil(err)
+	suite.Require().Equal(models.ImportStats{Inserted: 1}, gotStats)
+
+	gotPrice, err := repo.Get(context.Background(), "shop1", "Product 1")
+	suite.Require().Nil(err)
+	suite.Require().Equal(models.MustParsePrice("10"), gotPrice.Price)
+
+	gotPrice, err = repo.Get(context.Background(), "shop2", "Product 1")
+	suite.Require().Nil(err)
+	suite.Require().Equal(models.MustParsePrice("12"), gotPrice.Price)
+
+	gotPrice, err = repo.Get(context.Background(), "", "Product 1")
+	suite.Require().Nil(err)
+	suite.Require().Nil(gotPrice)
+
+	gotHistory, err := repo.History(context.Background(), "shop1", "Product 1", time.Time{}, time.Time{}, 0, 0)
+	suite.Require().Nil(err)
+	suite.Require().Len(gotHistory, 1)
+	suite.Require().Equal("shop1", gotHistory[0].Source)
+	suite.Require().Equal(models.MustParsePrice("10"), gotHistory[0].Price)
+}
+
+func (suite *PriceRepoTestSuite) TestDeleteMissing() {
+	hourAgo := time.Now().UTC().Add(-time.Hour)
+	now := time.Now().UTC()
+	repo := repos.NewPriceRepo(suite.db, 0)
+
+	suite.ClearCollection()
+
+	_, err := repo.Import(context.Background(), hourAgo, "shop1", &sliceReader{prices: []models.Price{
+		{Name: "Product 1", Price: models.MustParsePrice("10")},
+		{Name: "Product 2", Price: models.MustParsePrice("20")},
+		{Name: "Product 3", Price: models.MustParsePrice("30")},
+		{Name: "Product 4", Price: models.MustParsePrice("40")},
+	}})
+	suite.Require().Nil(err)
+	_, err = repo.Import(context.Background(), hourAgo, "shop2", &sliceReader{prices: []models.Price{
+		{Name: "Product 2", Price: models.MustParsePrice("25")},
+	}})
+	suite.Require().Nil(err)
+
+	// Feed misses 3 of 4 prices
+	_, err = repo.Import(context.Background(), now, "shop1", &sliceReader{prices: []models.Price{
+		{Name: "Product 1", Price: models.MustParsePrice("10")},
+	}})
+	suite.Require().Nil(err)
+
+	gotDeleted, err := repo.DeleteMissing(context.Background(), now, "shop1", 50)
+	suite.Require().True(errors.Is(err, models.ErrTooManyDeleted), err)
+	suite.Require().Equal(0, gotDeleted)
+
+	// Feed misses 1 of 4 prices
+	_, err = repo.Import(context.Background(), now, "shop1", &sliceReader{prices: []models.Price{
+		{Name: "Product 2", Price: models.MustParsePrice("20")},
+		{Name: "Product 3", Price: models.MustParsePrice("35")},
+	}})
+	suite.Require().Nil(err)
+
+	gotDeleted, err = repo.DeleteMissing(context.Background(), now, "shop1", 50)
+	suite.Require().Nil(err)
+	suite.Require().Equal(1, gotDeleted)
+
+	gotPrice, err := repo.Get(context.Background(), "shop1", "Product 4")
+	suite.Require().Nil(err)
+	suite.Require().Nil(gotPrice)
+
+	gotPage, err := repo.List(context.Background(), models.PriceQuery{Limit: 10, WithTotal: true})
+	suite.Require().Nil(err)
+	suite.Require().Len(gotPage.Prices, 4)
+	suite.Require().Equal(int64(4), *gotPage.Total)
+
+	// Deleted price is restored by the next import
+	gotStats, err := repo.Import(context.Background(), now.Add(time.Hour), "shop1", &sliceReader{prices: []models.Price{
+		{Name: "Product 4", Price: models.MustParsePrice("40")},
+	}})
+	suite.Require().Nil(err)
+	suite.Require().Equal(models.ImportStats{Unchanged: 1}, gotStats)
+
+	gotPrice, err = repo.Get(context.Background(), "shop1", "Product 4")
+	suite.Require().Nil(err)
+	suite.Require().Equal(models.MustParsePrice("40"), gotPrice.Price)
+
+	gotDeleted, err = repo.DeleteMissing(context.Background(), hourAgo, "shop2", 50)
+	suite.Require().Nil(err)
+	suite.Require().Equal(0, gotDeleted)
+}
+
+func (suite *PriceRepoTestSuite) TestListBestPrice() {
+	now := time.Now().UTC()
+	repo := repos.NewPriceRepo(suite.db, 0)
+
+	suite.ClearCollection()
+	for _, price := range []models.Price{
+		{Source: "shop1", Name: "Product 1", Price: models.MustParsePrice("10"), UpdatedAt: now},
+		{Source: "shop2", Name: "Product 1", Price: models.MustParsePrice("8"), UpdatedAt: now},
+		{Source: "shop1", Name: "Product 2", Price: models.MustParsePrice("5"), UpdatedAt: now},
+		{Source: "shop2", Name: "Product 2", Price: models.MustParsePrice("7"), UpdatedAt: now},
+		{Source: "shop2", Name: "Product 3", Price: models.MustParsePrice("20"), UpdatedAt: now},
+	} {
+		_, err := suite.collection.InsertOne(context.Background(), price)
+		suite.Require().Nil(err)
+	}
+
+	readPrices := func(query models.PriceQuery) []string {
+		var prices []string
+		for {
+			page, err := repo.List(context.Background(), query)
+			suite.Require().Nil(err)
+			for _, price := range page.Prices {
+				prices = append(prices, price.Source+" "+price.Name+" "+price.Price.String())
+			}
+			if page.NextPageToken == "" {
+				return prices
+			}
+			query.PageToken = page.NextPageToken
+		}
+	}
+
+	suite.Require().Equal(
+		[]string{"shop2 Product 1 8", "shop1 Product 2 5", "shop2 Product 3 20"},
+		readPrices(models.PriceQuery{Limit: 1, BestPrice: true}),
+	)
+	suite.Require().Equal(
+		[]string{"shop1 Product 2 5", "shop2 Product 1 8", "shop2 Product 3 20"},
+		readPrices(models.PriceQuery{Limit: 2, OrderBy: "price", BestPrice: true}),
+	)
+	suite.Require().Equal(
+		[]string{"shop1 Product 1 10", "shop1 Product 2 5"},
+		readPrices(models.PriceQuery{Filter: models.PriceFilter{Source: "shop1"}}),
+	)
+
+	minPrice := models.MustParsePrice("6")
+	page, err := repo.List(context.Background(), models.PriceQuery{Filter: models.PriceFilter{MinPrice: &minPrice}, BestPrice: true, WithTotal: true})
+	suite.Require().Nil(err)
+	suite.Require().Equal(int64(2), *page.Total)
+}
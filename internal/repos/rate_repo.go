@@ -0,0 +1,49 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"github.com/roman-wb/price-service/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const RateCollection = "rates"
+
+type RateRepo struct {
+	collection *mongo.Collection
+}
+
+func NewRateRepo(db *mongo.Database) *RateRepo {
+	return &RateRepo{
+		collection: db.Collection(RateCollection),
+	}
+}
+
+// Set replaces all rates: given currencies are upserted and the rest
+// are deleted, so prices in them are skipped by conversion.
+func (rr *RateRepo) Set(ctx context.Context, updatedAt time.Time, rates []models.Rate) error {
+	currencies := bson.A{}
+	upserts := []mongo.WriteModel{}
+	for _, rate := range rates {
+		currencies = append(currencies, rate.Currency)
+		upserts = append(upserts, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"currency": rate.Currency}).
+			SetUpdate(bson.M{"$set": bson.M{
+				"rate":       rate.Rate,
+				"updated_at": updatedAt,
+			}}).
+			SetUpsert(true))
+	}
+
+	if len(upserts) > 0 {
+		_, err := rr.collection.BulkWrite(ctx, upserts)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := rr.collection.DeleteMany(ctx, bson.M{"currency": bson.M{"$nin": currencies}})
+	return err
+}
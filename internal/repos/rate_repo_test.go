@@ -0,0 +1,91 @@
+package repos_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/roman-wb/price-service/internal/database"
+	"github.com/roman-wb/price-service/internal/models"
+	"github.com/roman-wb/price-service/internal/repos"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type RateRepoTestSuite struct {
+	suite.Suite
+
+	client     *mongo.Client
+	db         *mongo.Database
+	collection *mongo.Collection
+}
+
+func (suite *RateRepoTestSuite) ClearCollection() {
+	_, err := suite.collection.DeleteMany(context.Background(), bson.M{}, nil)
+	suite.Require().Nil(err)
+}
+
+func (suite *RateRepoTestSuite) SetupTest() {
+	client, err := database.NewClient(context.Background(), MongoURI, "file://../../migrations")
+	suite.Require().Nil(err)
+
+	suite.client = client
+	suite.db = suite.client.Database(MongoDB)
+	suite.collection = suite.db.Collection(repos.RateCollection)
+
+	suite.ClearCollection()
+}
+
+func (suite *RateRepoTestSuite) TearDownSuite() {
+	suite.ClearCollection()
+}
+
+func TestRateRepo(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	suite.Run(t, &RateRepoTestSuite{})
+}
+
+func (suite *RateRepoTestSuite) TestSet() {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	repo := repos.NewRateRepo(suite.db)
+
+	err := repo.Set(context.Background(), now, []models.Rate{
+		{Currency: "USD", Rate: models.MustParsePrice("1")},
+		{Currency: "EUR", Rate: models.MustParsePrice("0.85")},
+	})
+	suite.Require().Nil(err)
+
+	err = repo.Set(context.Background(), now, []models.Rate{
+		{Currency: "USD", Rate: models.MustParsePrice("1")},
+		{Currency: "RUB", Rate: models.MustParsePrice("73.5")},
+	})
+	suite.Require().Nil(err)
+
+	suite.Require().Equal([]models.Rate{
+		{Currency: "RUB", Rate: models.MustParsePrice("73.5"), UpdatedAt: now},
+		{Currency: "USD", Rate: models.MustParsePrice("1"), UpdatedAt: now},
+	}, suite.rates())
+
+	err = repo.Set(context.Background(), now, nil)
+	suite.Require().Nil(err)
+
+	suite.Require().Empty(suite.rates())
+}
+
+// rates returns stored rates sorted by currency.
+func (suite *RateRepoTestSuite) rates() []models.Rate {
+	opts := options.Find().SetSort(bson.D{{Key: "currency", Value: 1}})
+	cursor, err := suite.collection.Find(context.Background(), bson.M{}, opts)
+	suite.Require().Nil(err)
+
+	var rates []models.Rate
+	err = cursor.All(context.Background(), &rates)
+	suite.Require().Nil(err)
+
+	return rates
+}
@@ -0,0 +1,119 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"github.com/roman-wb/price-service/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const ScheduleCollection = "schedules"
+
+type ScheduleRepo struct {
+	collection *mongo.Collection
+}
+
+func NewScheduleRepo(db *mongo.Database) *ScheduleRepo {
+	return &ScheduleRepo{
+		collection: db.Collection(ScheduleCollection),
+	}
+}
+
+func (sr *ScheduleRepo) Create(ctx context.Context, schedule models.Schedule) (models.Schedule, error) {
+	result, err := sr.collection.InsertOne(ctx, schedule)
+	if err != nil {
+		return schedule, err
+	}
+
+	schedule.ID = result.InsertedID.(primitive.ObjectID)
+	return schedule, nil
+}
+
+func (sr *ScheduleRepo) List(ctx context.Context, skip int, limit int) ([]models.Schedule, error) {
+	skip, limit = normalizePaging(skip, limit)
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSkip(int64(skip)).
+		SetLimit(int64(limit))
+
+	cursor, err := sr.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedules []models.Schedule
+	err = cursor.All(ctx, &schedules)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// Delete returns false when schedule isn't found.
+func (sr *ScheduleRepo) Delete(ctx context.Context, id string) (bool, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return false, &models.FieldError{Field: "id", Err: err}
+	}
+
+	result, err := sr.collection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return false, err
+	}
+
+	return result.DeletedCount > 0, nil
+}
+
+// Due returns schedules which next run is before now, the oldest first.
+func (sr *ScheduleRepo) Due(ctx context.Context, now time.Time, limit int) ([]models.Schedule, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "next_run_at", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := sr.collection.Find(ctx, bson.M{"next_run_at": bson.M{"$lte": now}}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedules []models.Schedule
+	err = cursor.All(ctx, &schedules)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// Advance moves next run of the schedule if it wasn't moved yet and
+// returns true then. Instances race for a due schedule and only the one
+// which advanced it runs the feed.
+func (sr *ScheduleRepo) Advance(ctx context.Context, schedule models.Schedule, ranAt time.Time, nextRunAt time.Time) (bool, error) {
+	result, err := sr.collection.UpdateOne(
+		ctx,
+		bson.M{"_id": schedule.ID, "next_run_at": schedule.NextRunAt},
+		bson.M{"$set": bson.M{
+			"next_run_at": nextRunAt,
+			"last_run_at": ranAt,
+		}},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return result.ModifiedCount > 0, nil
+}
+
+func (sr *ScheduleRepo) SetLastJob(ctx context.Context, id primitive.ObjectID, jobID primitive.ObjectID) error {
+	_, err := sr.collection.UpdateOne(
+		ctx,
+		bson.M{"_id": id},
+		bson.M{"$set": bson.M{"last_job_id": jobID}},
+	)
+	return err
+}
@@ -0,0 +1,114 @@
+package repos_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/roman-wb/price-service/internal/database"
+	"github.com/roman-wb/price-service/internal/models"
+	"github.com/roman-wb/price-service/internal/repos"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type ScheduleRepoTestSuite struct {
+	suite.Suite
+
+	client     *mongo.Client
+	db         *mongo.Database
+	collection *mongo.Collection
+}
+
+func (suite *ScheduleRepoTestSuite) ClearCollection() {
+	_, err := suite.collection.DeleteMany(context.Background(), bson.M{}, nil)
+	suite.Require().Nil(err)
+}
+
+func (suite *ScheduleRepoTestSuite) SetupTest() {
+	client, err := database.NewClient(context.Background(), MongoURI, "file://../../migrations")
+	suite.Require().Nil(err)
+
+	suite.client = client
+	suite.db = suite.client.Database(MongoDB)
+	suite.collection = suite.db.Collection(repos.ScheduleCollection)
+
+	suite.ClearCollection()
+}
+
+func (suite *ScheduleRepoTestSuite) TearDownSuite() {
+	suite.ClearCollection()
+}
+
+func TestScheduleRepo(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	suite.Run(t, &ScheduleRepoTestSuite{})
+}
+
+func (suite *ScheduleRepoTestSuite) TestLifecycle() {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	repo := repos.NewScheduleRepo(suite.db)
+
+	schedule, err := repo.Create(context.Background(), models.Schedule{
+		Feed:      models.Feed{URL: "http://yandex.ru"},
+		Interval:  time.Hour,
+		NextRunAt: now,
+		CreatedAt: now,
+	})
+	suite.Require().Nil(err)
+	suite.Require().False(schedule.ID.IsZero())
+
+	_, err = repo.Create(context.Background(), models.Schedule{
+		Feed:      models.Feed{URL: "http://yandex.ru/later"},
+		Cron:      "0 * * * *",
+		NextRunAt: now.Add(time.Hour),
+		CreatedAt: now,
+	})
+	suite.Require().Nil(err)
+
+	due, err := repo.Due(context.Background(), now, 10)
+	suite.Require().Nil(err)
+	suite.Require().Len(due, 1)
+	suite.Require().Equal(schedule.ID, due[0].ID)
+
+	advanced, err := repo.Advance(context.Background(), due[0], now, now.Add(time.Hour))
+	suite.Require().Nil(err)
+	suite.Require().True(advanced)
+
+	advanced, err = repo.Advance(context.Background(), due[0], now, now.Add(time.Hour))
+	suite.Require().Nil(err)
+	suite.Require().False(advanced)
+
+	due, err = repo.Due(context.Background(), now, 10)
+	suite.Require().Nil(err)
+	suite.Require().Empty(due)
+
+	jobID := primitive.NewObjectID()
+	err = repo.SetLastJob(context.Background(), schedule.ID, jobID)
+	suite.Require().Nil(err)
+
+	schedules, err := repo.List(context.Background(), 0, 10)
+	suite.Require().Nil(err)
+	suite.Require().Len(schedules, 2)
+
+	deleted, err := repo.Delete(context.Background(), schedule.ID.Hex())
+	suite.Require().Nil(err)
+	suite.Require().True(deleted)
+
+	deleted, err = repo.Delete(context.Background(), schedule.ID.Hex())
+	suite.Require().Nil(err)
+	suite.Require().False(deleted)
+
+	deleted, err = repo.Delete(context.Background(), "invalid")
+	suite.Require().True(errors.Is(err, primitive.ErrInvalidHex), err)
+	var fieldErr *models.FieldError
+	suite.Require().True(errors.As(err, &fieldErr))
+	suite.Require().Equal("id", fieldErr.Field)
+	suite.Require().False(deleted)
+}
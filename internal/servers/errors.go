@@ -0,0 +1,71 @@
+package servers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/roman-wb/price-service/internal/models"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain is domain of ErrorInfo of returned statuses.
+const ErrorDomain = "price-service"
+
+// statusError converts err to gRPC status by models.ErrorReason. Status
+// has ErrorInfo with reason, invalid field is described by BadRequest.
+// Statuses are returned as is.
+func statusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	code, reason := models.ErrorReason(err)
+	details := []proto.Message{
+		&errdetails.ErrorInfo{Reason: reason, Domain: ErrorDomain},
+	}
+
+	var fieldErr *models.FieldError
+	if errors.As(err, &fieldErr) {
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: fieldErr.Field, Description: fieldErr.Error()},
+			},
+		})
+	}
+
+	return newStatus(code, err.Error(), details...)
+}
+
+// invalidArgument is status of invalid field of request.
+func invalidArgument(field string, err error) error {
+	return statusError(&models.FieldError{Field: field, Err: err})
+}
+
+func notFound(format string, args ...interface{}) error {
+	return newStatus(codes.NotFound, fmt.Sprintf(format, args...), &errdetails.ErrorInfo{
+		Reason: models.ReasonNotFound,
+		Domain: ErrorDomain,
+	})
+}
+
+func newStatus(code codes.Code, message string, details ...proto.Message) error {
+	st := status.New(code, message)
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// prefixField nests field of models.FieldError into parent field, like
+// "url" of feed into "feed.url". Other errors become error of parent.
+func prefixField(parent string, err error) error {
+	var fieldErr *models.FieldError
+	if errors.As(err, &fieldErr) {
+		return &models.FieldError{Field: parent + "." + fieldErr.Field, Err: fieldErr.Err}
+	}
+	return &models.FieldError{Field: parent, Err: err}
+}
@@ -0,0 +1,151 @@
+package servers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/roman-wb/price-service/internal/models"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// requireStatus compares gRPC statuses with details.
+func requireStatus(t *testing.T, want error, got error) {
+	t.Helper()
+
+	if want == nil {
+		require.Nil(t, got)
+		return
+	}
+
+	wantStatus, ok := status.FromError(want)
+	require.True(t, ok, "want isn't status: %v", want)
+	gotStatus, ok := status.FromError(got)
+	require.True(t, ok, "got isn't status: %v", got)
+	require.True(t, proto.Equal(wantStatus.Proto(), gotStatus.Proto()), "want %v, got %v", wantStatus.Proto(), gotStatus.Proto())
+}
+
+func TestStatusError(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		err error
+
+		wantCode       codes.Code
+		wantMessage    string
+		wantReason     string
+		wantViolations []*errdetails.BadRequest_FieldViolation
+	}{
+		{
+			name: "Invalid field",
+
+			err: &models.FieldError{Field: "url", Err: errors.New(`parse "": empty url`)},
+
+			wantCode:    codes.InvalidArgument,
+			wantMessage: `parse "": empty url`,
+			wantReason:  models.ReasonInvalidArgument,
+			wantViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "url", Description: `parse "": empty url`},
+			},
+		},
+		{
+			name: "Forbidden url",
+
+			err: &models.FieldError{Field: "url", Err: fmt.Errorf("%w: private address 127.0.0.1", models.ErrForbiddenURL)},
+
+			wantCode:    codes.InvalidArgument,
+			wantMessage: "forbidden url: private address 127.0.0.1",
+			wantReason:  models.ReasonForbiddenURL,
+			wantViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "url", Description: "forbidden url: private address 127.0.0.1"},
+			},
+		},
+		{
+			name: "Feed response status",
+
+			err: &models.StatusError{StatusCode: 404, Status: "404 Not Found"},
+
+			wantCode:    codes.FailedPrecondition,
+			wantMessage: "feed response status 404 Not Found",
+			wantReason:  models.ReasonUpstreamStatus,
+		},
+		{
+			name: "Unreachable upstream",
+
+			err: fmt.Errorf("Get \"http://yandex.ru\": %w", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}),
+
+			wantCode:    codes.Unavailable,
+			wantMessage: `Get "http://yandex.ru": dial tcp: connection refused`,
+			wantReason:  models.ReasonUnavailable,
+		},
+		{
+			name: "Timeout",
+
+			err: fmt.Errorf("server selection error: %w", context.DeadlineExceeded),
+
+			wantCode:    codes.DeadlineExceeded,
+			wantMessage: "server selection error: context deadline exceeded",
+			wantReason:  models.ReasonTimeout,
+		},
+		{
+			name: "Unknown error",
+
+			err: errors.New("some error..."),
+
+			wantCode:    codes.Unknown,
+			wantMessage: "some error...",
+			wantReason:  models.ReasonUnknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := status.Convert(statusError(tc.err))
+
+			require.Equal(t, tc.wantCode, got.Code())
+			require.Equal(t, tc.wantMessage, got.Message())
+
+			var gotInfo *errdetails.ErrorInfo
+			var gotViolations []*errdetails.BadRequest_FieldViolation
+			for _, detail := range got.Details() {
+				switch detail := detail.(type) {
+				case *errdetails.ErrorInfo:
+					gotInfo = detail
+				case *errdetails.BadRequest:
+					gotViolations = detail.FieldViolations
+				}
+			}
+			require.NotNil(t, gotInfo)
+			require.Equal(t, tc.wantReason, gotInfo.Reason)
+			require.Equal(t, ErrorDomain, gotInfo.Domain)
+			require.Equal(t, len(tc.wantViolations), len(gotViolations))
+			for i := range tc.wantViolations {
+				require.True(t, proto.Equal(tc.wantViolations[i], gotViolations[i]))
+			}
+		})
+	}
+}
+
+func TestStatusErrorKeepsStatus(t *testing.T) {
+	err := status.Error(codes.NotFound, "not found")
+
+	require.Equal(t, err, statusError(err))
+}
+
+func TestPrefixField(t *testing.T) {
+	got := prefixField("feed", &models.FieldError{Field: "url", Err: models.ErrForbiddenURL})
+
+	var fieldErr *models.FieldError
+	require.True(t, errors.As(got, &fieldErr))
+	require.Equal(t, "feed.url", fieldErr.Field)
+	require.True(t, errors.Is(got, models.ErrForbiddenURL))
+}
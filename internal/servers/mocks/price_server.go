@@ -1,10 +1,11 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/roman-wb/price-service/internal/servers (interfaces: Logger,Parser,PriceRepo)
+// Source: github.com/roman-wb/price-service/internal/servers (interfaces: Logger,Importer,PriceRepo,JobRepo,RateRepo,ScheduleRepo)
 
 // Package mocks is a generated GoMock package.
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 	time "time"
 
@@ -52,42 +53,56 @@ func (mr *MockLoggerMockRecorder) Infof(arg0 interface{}, arg1 ...interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Infof", reflect.TypeOf((*MockLogger)(nil).Infof), varargs...)
 }
 
-// MockParser is a mock of Parser interface.
-type MockParser struct {
+// MockImporter is a mock of Importer interface.
+type MockImporter struct {
 	ctrl     *gomock.Controller
-	recorder *MockParserMockRecorder
+	recorder *MockImporterMockRecorder
 }
 
-// MockParserMockRecorder is the mock recorder for MockParser.
-type MockParserMockRecorder struct {
-	mock *MockParser
+// MockImporterMockRecorder is the mock recorder for MockImporter.
+type MockImporterMockRecorder struct {
+	mock *MockImporter
 }
 
-// NewMockParser creates a new mock instance.
-func NewMockParser(ctrl *gomock.Controller) *MockParser {
-	mock := &MockParser{ctrl: ctrl}
-	mock.recorder = &MockParserMockRecorder{mock}
+// NewMockImporter creates a new mock instance.
+func NewMockImporter(ctrl *gomock.Controller) *MockImporter {
+	mock := &MockImporter{ctrl: ctrl}
+	mock.recorder = &MockImporterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockParser) EXPECT() *MockParserMockRecorder {
+func (m *MockImporter) EXPECT() *MockImporterMockRecorder {
 	return m.recorder
 }
 
-// Fetch mocks base method.
-func (m *MockParser) Fetch(arg0 string) ([]models.Price, error) {
+// Submit mocks base method.
+func (m *MockImporter) Submit(arg0 context.Context, arg1 models.Feed, arg2 time.Time) (models.Job, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Fetch", arg0)
-	ret0, _ := ret[0].([]models.Price)
+	ret := m.ctrl.Call(m, "Submit", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.Job)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Fetch indicates an expected call of Fetch.
-func (mr *MockParserMockRecorder) Fetch(arg0 interface{}) *gomock.Call {
+// Submit indicates an expected call of Submit.
+func (mr *MockImporterMockRecorder) Submit(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockParser)(nil).Fetch), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockImporter)(nil).Submit), arg0, arg1, arg2)
+}
+
+// Validate mocks base method.
+func (m *MockImporter) Validate(arg0 models.Feed) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockImporterMockRecorder) Validate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockImporter)(nil).Validate), arg0)
 }
 
 // MockPriceRepo is a mock of PriceRepo interface.
@@ -113,31 +128,220 @@ func (m *MockPriceRepo) EXPECT() *MockPriceRepoMockRecorder {
 	return m.recorder
 }
 
-// Import mocks base method.
-func (m *MockPriceRepo) Import(arg0 time.Time, arg1 []models.Price) error {
+// Get mocks base method.
+func (m *MockPriceRepo) Get(arg0 context.Context, arg1, arg2 string) (*models.Price, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Price)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPriceRepoMockRecorder) Get(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPriceRepo)(nil).Get), arg0, arg1, arg2)
+}
+
+// GetMany mocks base method.
+func (m *MockPriceRepo) GetMany(arg0 context.Context, arg1 string, arg2 []string) ([]models.Price, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMany", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Price)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMany indicates an expected call of GetMany.
+func (mr *MockPriceRepoMockRecorder) GetMany(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMany", reflect.TypeOf((*MockPriceRepo)(nil).GetMany), arg0, arg1, arg2)
+}
+
+// History mocks base method.
+func (m *MockPriceRepo) History(arg0 context.Context, arg1, arg2 string, arg3, arg4 time.Time, arg5, arg6 int) ([]models.PriceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].([]models.PriceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockPriceRepoMockRecorder) History(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockPriceRepo)(nil).History), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// List mocks base method.
+func (m *MockPriceRepo) List(arg0 context.Context, arg1 models.PriceQuery) (models.PricePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(models.PricePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPriceRepoMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPriceRepo)(nil).List), arg0, arg1)
+}
+
+// MockJobRepo is a mock of JobRepo interface.
+type MockJobRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobRepoMockRecorder
+}
+
+// MockJobRepoMockRecorder is the mock recorder for MockJobRepo.
+type MockJobRepoMockRecorder struct {
+	mock *MockJobRepo
+}
+
+// NewMockJobRepo creates a new mock instance.
+func NewMockJobRepo(ctrl *gomock.Controller) *MockJobRepo {
+	mock := &MockJobRepo{ctrl: ctrl}
+	mock.recorder = &MockJobRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobRepo) EXPECT() *MockJobRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockJobRepo) Get(arg0 context.Context, arg1 string) (*models.Job, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Import", arg0, arg1)
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*models.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockJobRepoMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockJobRepo)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockJobRepo) List(arg0 context.Context, arg1, arg2 int) ([]models.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockJobRepoMockRecorder) List(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockJobRepo)(nil).List), arg0, arg1, arg2)
+}
+
+// MockRateRepo is a mock of RateRepo interface.
+type MockRateRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateRepoMockRecorder
+}
+
+// MockRateRepoMockRecorder is the mock recorder for MockRateRepo.
+type MockRateRepoMockRecorder struct {
+	mock *MockRateRepo
+}
+
+// NewMockRateRepo creates a new mock instance.
+func NewMockRateRepo(ctrl *gomock.Controller) *MockRateRepo {
+	mock := &MockRateRepo{ctrl: ctrl}
+	mock.recorder = &MockRateRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateRepo) EXPECT() *MockRateRepoMockRecorder {
+	return m.recorder
+}
+
+// Set mocks base method.
+func (m *MockRateRepo) Set(arg0 context.Context, arg1 time.Time, arg2 []models.Rate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Import indicates an expected call of Import.
-func (mr *MockPriceRepoMockRecorder) Import(arg0, arg1 interface{}) *gomock.Call {
+// Set indicates an expected call of Set.
+func (mr *MockRateRepoMockRecorder) Set(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockPriceRepo)(nil).Import), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockRateRepo)(nil).Set), arg0, arg1, arg2)
+}
+
+// MockScheduleRepo is a mock of ScheduleRepo interface.
+type MockScheduleRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleRepoMockRecorder
+}
+
+// MockScheduleRepoMockRecorder is the mock recorder for MockScheduleRepo.
+type MockScheduleRepoMockRecorder struct {
+	mock *MockScheduleRepo
+}
+
+// NewMockScheduleRepo creates a new mock instance.
+func NewMockScheduleRepo(ctrl *gomock.Controller) *MockScheduleRepo {
+	mock := &MockScheduleRepo{ctrl: ctrl}
+	mock.recorder = &MockScheduleRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduleRepo) EXPECT() *MockScheduleRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockScheduleRepo) Create(arg0 context.Context, arg1 models.Schedule) (models.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(models.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockScheduleRepoMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockScheduleRepo)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockScheduleRepo) Delete(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockScheduleRepoMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockScheduleRepo)(nil).Delete), arg0, arg1)
 }
 
 // List mocks base method.
-func (m *MockPriceRepo) List(arg0, arg1 int, arg2 string, arg3 int32) ([]models.Price, error) {
+func (m *MockScheduleRepo) List(arg0 context.Context, arg1, arg2 int) ([]models.Schedule, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].([]models.Price)
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Schedule)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockPriceRepoMockRecorder) List(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockScheduleRepoMockRecorder) List(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPriceRepo)(nil).List), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockScheduleRepo)(nil).List), arg0, arg1, arg2)
 }
@@ -1,72 +1,397 @@
-//go:generate mockgen -destination mocks/price_server.go -package=mocks . Logger,Parser,PriceRepo
+//go:generate mockgen -destination mocks/price_server.go -package=mocks . Logger,Importer,PriceRepo,JobRepo,RateRepo,ScheduleRepo
 
 package servers
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
 	"time"
 
 	"github.com/roman-wb/price-service/internal/models"
 	pb "github.com/roman-wb/price-service/internal/proto"
 )
 
+// MaxNames caps count of names requested by GetPrices.
+const MaxNames = 1000
+
 type Logger interface {
 	Infof(template string, args ...interface{})
 }
 
-type Parser interface {
-	Fetch(rawurl string) ([]models.Price, error)
+type Importer interface {
+	Validate(feed models.Feed) error
+	Submit(ctx context.Context, feed models.Feed, deadline time.Time) (models.Job, error)
 }
 
 type PriceRepo interface {
-	Import(updatedAt time.Time, prices []models.Price) error
-	List(skip int, limit int, orderBy string, orderType int32) ([]models.Price, error)
+	List(ctx context.Context, query models.PriceQuery) (models.PricePage, error)
+	Get(ctx context.Context, source string, name string) (*models.Price, error)
+	GetMany(ctx context.Context, source string, names []string) ([]models.Price, error)
+	History(ctx context.Context, source string, name string, from time.Time, to time.Time, skip int, limit int) ([]models.PriceHistory, error)
+}
+
+type JobRepo interface {
+	Get(ctx context.Context, id string) (*models.Job, error)
+	List(ctx context.Context, skip int, limit int) ([]models.Job, error)
+}
+
+type RateRepo interface {
+	Set(ctx context.Context, updatedAt time.Time, rates []models.Rate) error
+}
+
+type ScheduleRepo interface {
+	Create(ctx context.Context, schedule models.Schedule) (models.Schedule, error)
+	List(ctx context.Context, skip int, limit int) ([]models.Schedule, error)
+	Delete(ctx context.Context, id string) (bool, error)
 }
 
 type PriceServer struct {
 	pb.UnimplementedPriceServer
 
-	logger    Logger
-	parser    Parser
-	priceRepo PriceRepo
+	logger       Logger
+	importer     Importer
+	priceRepo    PriceRepo
+	jobRepo      JobRepo
+	rateRepo     RateRepo
+	scheduleRepo ScheduleRepo
 }
 
-func NewPriceServer(logger Logger, parser Parser, priceRepo PriceRepo) PriceServer {
+func NewPriceServer(logger Logger, importer Importer, priceRepo PriceRepo, jobRepo JobRepo, rateRepo RateRepo, scheduleRepo ScheduleRepo) PriceServer {
 	return PriceServer{
-		logger:    logger,
-		parser:    parser,
-		priceRepo: priceRepo,
+		logger:       logger,
+		importer:     importer,
+		priceRepo:    priceRepo,
+		jobRepo:      jobRepo,
+		rateRepo:     rateRepo,
+		scheduleRepo: scheduleRepo,
 	}
 }
 
 func (s *PriceServer) Fetch(ctx context.Context, in *pb.FetchRequest) (*pb.FetchReply, error) {
 	s.logger.Infof("Received: %v", in)
 
-	prices, err := s.parser.Fetch(in.Url)
-	if err != nil {
-		return nil, err
+	// Job outlives the call, it's bounded by deadline of call on request
+	var deadline time.Time
+	if in.UseDeadline {
+		deadline, _ = ctx.Deadline()
 	}
 
-	err = s.priceRepo.Import(time.Now().UTC(), prices)
+	job, err := s.importer.Submit(ctx, models.FeedFromPB(in), deadline)
 	if err != nil {
-		return nil, err
+		return nil, statusError(err)
 	}
 
-	return &pb.FetchReply{}, nil
+	return &pb.FetchReply{JobId: job.ID.Hex()}, nil
 }
 
 func (s *PriceServer) List(ctx context.Context, in *pb.ListRequest) (*pb.ListReply, error) {
 	s.logger.Infof("Received: %v", in)
 
-	prices, err := s.priceRepo.List(int(in.Skip), int(in.Limit), in.OrderBy, in.OrderType)
+	filter, err := priceFilter(in)
 	if err != nil {
 		return nil, err
 	}
 
+	currency, err := models.ParseCurrency(in.Currency)
+	if err != nil {
+		return nil, invalidArgument("currency", err)
+	}
+
+	page, err := s.priceRepo.List(ctx, models.PriceQuery{
+		Skip:      int(in.Skip),
+		Limit:     int(in.Limit),
+		OrderBy:   in.OrderBy,
+		OrderType: in.OrderType,
+		PageToken: in.PageToken,
+		Filter:    filter,
+		WithTotal: !in.SkipTotal,
+		Currency:  currency,
+		BestPrice: in.BestPrice,
+	})
+	if err == models.ErrInvalidPageToken {
+		return nil, invalidArgument("page_token", err)
+	}
+	if err != nil {
+		return nil, statusError(err)
+	}
+
 	results := []*pb.ListReply_Price{}
+	for _, price := range page.Prices {
+		results = append(results, price.ToPBListReplyPrice())
+	}
+
+	return &pb.ListReply{
+		Results:       results,
+		NextPageToken: page.NextPageToken,
+		Total:         page.Total,
+	}, nil
+}
+
+func (s *PriceServer) GetPrice(ctx context.Context, in *pb.GetPriceRequest) (*pb.GetPriceReply, error) {
+	s.logger.Infof("Received: %v", in)
+
+	if in.Name == "" {
+		return nil, invalidArgument("name", errors.New("name is required"))
+	}
+
+	price, err := s.priceRepo.Get(ctx, in.Source, in.Name)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	if price == nil {
+		return nil, notFound("price %q not found", in.Name)
+	}
+
+	return &pb.GetPriceReply{Price: price.ToPBListReplyPrice()}, nil
+}
+
+func (s *PriceServer) GetPrices(ctx context.Context, in *pb.GetPricesRequest) (*pb.GetPricesReply, error) {
+	s.logger.Infof("Received: %v", in)
+
+	if len(in.Names) > MaxNames {
+		return nil, invalidArgument("names", fmt.Errorf("too many names, max %d", MaxNames))
+	}
+
+	prices, err := s.priceRepo.GetMany(ctx, in.Source, in.Names)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	found := make(map[string]models.Price, len(prices))
 	for _, price := range prices {
+		found[price.Name] = price
+	}
+
+	results := []*pb.ListReply_Price{}
+	notFound := []string{}
+	for _, name := range in.Names {
+		price, ok := found[name]
+		if !ok {
+			notFound = append(notFound, name)
+			continue
+		}
 		results = append(results, price.ToPBListReplyPrice())
 	}
 
-	return &pb.ListReply{Results: results}, nil
+	return &pb.GetPricesReply{Results: results, NotFound: notFound}, nil
+}
+
+func priceFilter(in *pb.ListRequest) (models.PriceFilter, error) {
+	filter := models.PriceFilter{
+		Source:       in.Source,
+		NamePrefix:   in.NamePrefix,
+		NameContains: in.NameContains,
+		MinChanges:   in.MinChanges,
+		MaxChanges:   in.MaxChanges,
+	}
+	if in.MinPrice != nil {
+		price, err := models.ParsePrice(*in.MinPrice)
+		if err != nil {
+			return filter, invalidArgument("min_price", fmt.Errorf("invalid min_price %q", *in.MinPrice))
+		}
+		filter.MinPrice = &price
+	}
+	if in.MaxPrice != nil {
+		price, err := models.ParsePrice(*in.MaxPrice)
+		if err != nil {
+			return filter, invalidArgument("max_price", fmt.Errorf("invalid max_price %q", *in.MaxPrice))
+		}
+		filter.MaxPrice = &price
+	}
+	if in.UpdatedFrom != nil {
+		filter.UpdatedFrom = in.UpdatedFrom.AsTime()
+	}
+	if in.UpdatedTo != nil {
+		filter.UpdatedTo = in.UpdatedTo.AsTime()
+	}
+	return filter, nil
+}
+
+func (s *PriceServer) GetHistory(ctx context.Context, in *pb.GetHistoryRequest) (*pb.GetHistoryReply, error) {
+	s.logger.Infof("Received: %v", in)
+
+	var from, to time.Time
+	if in.From != nil {
+		from = in.From.AsTime()
+	}
+	if in.To != nil {
+		to = in.To.AsTime()
+	}
+
+	history, err := s.priceRepo.History(ctx, in.Source, in.Name, from, to, int(in.Skip), int(in.Limit))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	results := []*pb.GetHistoryReply_Price{}
+	for _, price := range history {
+		results = append(results, price.ToPBGetHistoryReplyPrice())
+	}
+
+	return &pb.GetHistoryReply{Results: results}, nil
+}
+
+func (s *PriceServer) GetJob(ctx context.Context, in *pb.GetJobRequest) (*pb.GetJobReply, error) {
+	s.logger.Infof("Received: %v", in)
+
+	job, err := s.jobRepo.Get(ctx, in.Id)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	if job == nil {
+		return nil, notFound("job %s not found", in.Id)
+	}
+
+	return &pb.GetJobReply{Job: job.ToPBJob()}, nil
+}
+
+func (s *PriceServer) ListJobs(ctx context.Context, in *pb.ListJobsRequest) (*pb.ListJobsReply, error) {
+	s.logger.Infof("Received: %v", in)
+
+	jobs, err := s.jobRepo.List(ctx, int(in.Skip), int(in.Limit))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	results := []*pb.Job{}
+	for _, job := range jobs {
+		results = append(results, job.ToPBJob())
+	}
+
+	return &pb.ListJobsReply{Results: results}, nil
+}
+
+// SetRates replaces all rates by rates of the base currency, the base
+// itself has rate 1.
+func (s *PriceServer) SetRates(ctx context.Context, in *pb.SetRatesRequest) (*pb.SetRatesReply, error) {
+	s.logger.Infof("Received: %v", in)
+
+	rates, err := parseRates(in)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	err = s.rateRepo.Set(ctx, time.Now().UTC(), rates)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.SetRatesReply{}, nil
+}
+
+// parseRates returns rates sorted by currency. Rates may include the base
+// only with rate 1. Errors are models.FieldError.
+func parseRates(in *pb.SetRatesRequest) ([]models.Rate, error) {
+	base, err := models.ParseCurrency(in.Base)
+	if err != nil {
+		return nil, &models.FieldError{Field: "base", Err: err}
+	}
+	if base == "" {
+		return nil, &models.FieldError{Field: "base", Err: errors.New("base is required")}
+	}
+
+	rates := []models.Rate{{Currency: base, Rate: models.MustParsePrice("1")}}
+	for key, value := range in.Rates {
+		currency, err := models.ParseCurrency(key)
+		if err != nil {
+			return nil, &models.FieldError{Field: "rates", Err: err}
+		}
+		if currency == "" {
+			return nil, &models.FieldError{Field: "rates", Err: errors.New("currency of rate is required")}
+		}
+
+		rate, err := models.ParseRate(value)
+		if err != nil {
+			return nil, &models.FieldError{Field: "rates", Err: err}
+		}
+
+		if currency == base {
+			one, _ := new(big.Rat).SetString(rate.String())
+			if one.Cmp(big.NewRat(1, 1)) != 0 {
+				return nil, &models.FieldError{Field: "rates", Err: fmt.Errorf("rate of base %s must be 1", base)}
+			}
+			continue
+		}
+		for _, r := range rates {
+			if r.Currency == currency {
+				return nil, &models.FieldError{Field: "rates", Err: fmt.Errorf("duplicate rate of %s", currency)}
+			}
+		}
+
+		rates = append(rates, models.Rate{Currency: currency, Rate: rate})
+	}
+
+	sort.Slice(rates, func(i, j int) bool { return rates[i].Currency < rates[j].Currency })
+	return rates, nil
+}
+
+// CreateSchedule validates feed and schedule, the first run is the next
+// run after now.
+func (s *PriceServer) CreateSchedule(ctx context.Context, in *pb.CreateScheduleRequest) (*pb.CreateScheduleReply, error) {
+	s.logger.Infof("Received: %v", in)
+
+	if in.Feed == nil {
+		return nil, invalidArgument("feed", errors.New("feed is required"))
+	}
+
+	now := time.Now().UTC()
+	schedule := models.Schedule{
+		Feed:      models.FeedFromPB(in.Feed),
+		Cron:      in.Cron,
+		CreatedAt: now,
+	}
+	if in.Interval != nil {
+		schedule.Interval = in.Interval.AsDuration()
+	}
+
+	err := schedule.Validate()
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	err = s.importer.Validate(schedule.Feed)
+	if err != nil {
+		return nil, statusError(prefixField("feed", err))
+	}
+
+	schedule.NextRunAt = schedule.Next(now)
+	schedule, err = s.scheduleRepo.Create(ctx, schedule)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.CreateScheduleReply{Schedule: schedule.ToPBSchedule()}, nil
+}
+
+func (s *PriceServer) ListSchedules(ctx context.Context, in *pb.ListSchedulesRequest) (*pb.ListSchedulesReply, error) {
+	s.logger.Infof("Received: %v", in)
+
+	schedules, err := s.scheduleRepo.List(ctx, int(in.Skip), int(in.Limit))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	results := []*pb.Schedule{}
+	for _, schedule := range schedules {
+		results = append(results, schedule.ToPBSchedule())
+	}
+
+	return &pb.ListSchedulesReply{Results: results}, nil
+}
+
+func (s *PriceServer) DeleteSchedule(ctx context.Context, in *pb.DeleteScheduleRequest) (*pb.DeleteScheduleReply, error) {
+	s.logger.Infof("Received: %v", in)
+
+	deleted, err := s.scheduleRepo.Delete(ctx, in.Id)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	if !deleted {
+		return nil, notFound("schedule %s not found", in.Id)
+	}
+
+	return &pb.DeleteScheduleReply{}, nil
 }
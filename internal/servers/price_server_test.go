@@ -3,6 +3,8 @@ package servers
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,6 +13,8 @@ import (
 	pb "github.com/roman-wb/price-service/internal/proto"
 	"github.com/roman-wb/price-service/internal/servers/mocks"
 	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -20,70 +24,105 @@ func TestNewPriceServer(t *testing.T) {
 
 	wantMockLogger := mocks.NewMockLogger(ctrl)
 	wantMockLogger.EXPECT().Infof(gomock.Any()).AnyTimes()
-	wantMockParser := mocks.NewMockParser(ctrl)
+	wantMockImporter := mocks.NewMockImporter(ctrl)
 	wantMockPriceRepo := mocks.NewMockPriceRepo(ctrl)
+	wantMockJobRepo := mocks.NewMockJobRepo(ctrl)
+	wantMockRateRepo := mocks.NewMockRateRepo(ctrl)
+	wantMockScheduleRepo := mocks.NewMockScheduleRepo(ctrl)
 
-	gotPriceServer := NewPriceServer(wantMockLogger, wantMockParser, wantMockPriceRepo)
+	gotPriceServer := NewPriceServer(wantMockLogger, wantMockImporter, wantMockPriceRepo, wantMockJobRepo, wantMockRateRepo, wantMockScheduleRepo)
 
 	require.NotNil(t, gotPriceServer)
 	require.Equal(t, wantMockLogger, gotPriceServer.logger)
-	require.Equal(t, wantMockParser, gotPriceServer.parser)
+	require.Equal(t, wantMockImporter, gotPriceServer.importer)
 	require.Equal(t, wantMockPriceRepo, gotPriceServer.priceRepo)
+	require.Equal(t, wantMockJobRepo, gotPriceServer.jobRepo)
+	require.Equal(t, wantMockRateRepo, gotPriceServer.rateRepo)
+	require.Equal(t, wantMockScheduleRepo, gotPriceServer.scheduleRepo)
 }
 
 func TestPriceServerFetch(t *testing.T) {
+	id := primitive.NewObjectID()
+	deadline := time.Now().Add(time.Minute).UTC()
+
 	testCases := []struct {
 		name string
 
-		url             string
-		isMockPriceRepo bool
+		request  *pb.FetchRequest
+		deadline time.Time
 
-		mockParserPrices []models.Price
-		mockParserErr    error
-		mockPriceRepoErr error
+		wantFeed        models.Feed
+		wantDeadline    time.Time
+		mockImporterJob models.Job
+		mockImporterErr error
 
 		wantReply *pb.FetchReply
 		wantErr   error
 	}{
 		{
-			name: "Parser returns error",
+			name: "Importer returns error",
 
-			url: "",
+			request: &pb.FetchRequest{Url: ""},
 
-			mockParserErr: errors.New(`parse "": empty url`),
+			wantFeed:        models.Feed{},
+			mockImporterErr: &models.FieldError{Field: "url", Err: errors.New(`parse "": empty url`)},
 
 			wantReply: nil,
-			wantErr:   errors.New(`parse "": empty url`),
+			wantErr:   invalidArgument("url", errors.New(`parse "": empty url`)),
 		},
 		{
-			name: "Repo returns error",
+			name: "Response without errors",
 
-			url:             "http://yandex.ru",
-			isMockPriceRepo: true,
+			request: &pb.FetchRequest{Url: "http://yandex.ru"},
 
-			mockParserPrices: []models.Price{
-				{Name: "Product 1", Price: 0},
-				{Name: "Product 2", Price: 100.99},
-			},
-			mockPriceRepoErr: errors.New(`some error...`),
+			wantFeed:        models.Feed{URL: "http://yandex.ru"},
+			mockImporterJob: models.Job{ID: id, Feed: models.Feed{URL: "http://yandex.ru"}, State: models.JobQueued},
+			mockImporterErr: nil,
 
-			wantReply: nil,
-			wantErr:   errors.New(`some error...`),
+			wantReply: &pb.FetchReply{JobId: id.Hex()},
+			wantErr:   nil,
 		},
 		{
-			name: "Response without errors",
+			name: "Deadline of caller isn't passed to job by default",
 
-			url:             "http://yandex.ru",
-			isMockPriceRepo: true,
+			request:  &pb.FetchRequest{Url: "http://yandex.ru"},
+			deadline: deadline,
+
+			wantFeed:        models.Feed{URL: "http://yandex.ru"},
+			mockImporterJob: models.Job{ID: id, Feed: models.Feed{URL: "http://yandex.ru"}, State: models.JobQueued},
+
+			wantReply: &pb.FetchReply{JobId: id.Hex()},
+			wantErr:   nil,
+		},
+		{
+			name: "Deadline of caller is passed to job on request",
 
-			mockParserPrices: []models.Price{
-				{Name: "Product 1", Price: 0},
-				{Name: "Product 2", Price: 100.99},
+			request:  &pb.FetchRequest{Url: "http://yandex.ru", UseDeadline: true},
+			deadline: deadline,
+
+			wantFeed:        models.Feed{URL: "http://yandex.ru"},
+			wantDeadline:    deadline,
+			mockImporterJob: models.Job{ID: id, Feed: models.Feed{URL: "http://yandex.ru"}, State: models.JobQueued},
+
+			wantReply: &pb.FetchReply{JobId: id.Hex()},
+			wantErr:   nil,
+		},
+		{
+			name: "Response with CSV format",
+
+			request: &pb.FetchRequest{
+				Url: "http://yandex.ru",
+				Csv: &pb.FetchRequest_CsvFormat{Delimiter: ",", Header: true, NameColumn: 2, PriceColumn: 3},
 			},
-			mockParserErr:    nil,
-			mockPriceRepoErr: nil,
 
-			wantReply: &pb.FetchReply{},
+			wantFeed: models.Feed{
+				URL: "http://yandex.ru",
+				CSV: models.CSVFormat{Delimiter: ",", Header: true, NameColumn: 2, PriceColumn: 3},
+			},
+			mockImporterJob: models.Job{ID: id, State: models.JobQueued},
+			mockImporterErr: nil,
+
+			wantReply: &pb.FetchReply{JobId: id.Hex()},
 			wantErr:   nil,
 		},
 	}
@@ -98,33 +137,33 @@ func TestPriceServerFetch(t *testing.T) {
 
 			mockLogger := mocks.NewMockLogger(ctrl)
 			mockLogger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
-			mockParser := mocks.NewMockParser(ctrl)
-			mockParser.
+			mockImporter := mocks.NewMockImporter(ctrl)
+			mockImporter.
 				EXPECT().
-				Fetch(tc.url).
-				Return(tc.mockParserPrices, tc.mockParserErr)
+				Submit(gomock.Any(), tc.wantFeed, tc.wantDeadline).
+				Return(tc.mockImporterJob, tc.mockImporterErr)
 
-			mockPriceRepo := mocks.NewMockPriceRepo(ctrl)
-			if tc.isMockPriceRepo {
-				mockPriceRepo.
-					EXPECT().
-					Import(gomock.Any(), tc.mockParserPrices).
-					Return(tc.mockPriceRepoErr)
+			ctx := context.Background()
+			if !tc.deadline.IsZero() {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithDeadline(ctx, tc.deadline)
+				defer cancel()
 			}
 
-			priceServer := NewPriceServer(mockLogger, mockParser, mockPriceRepo)
-			request := &pb.FetchRequest{Url: tc.url}
-
-			gotReply, gotErr := priceServer.Fetch(context.Background(), request)
+			priceServer := NewPriceServer(mockLogger, mockImporter, nil, nil, nil, nil)
+			gotReply, gotErr := priceServer.Fetch(ctx, tc.request)
 
 			require.Equal(t, tc.wantReply, gotReply)
-			require.Equal(t, tc.wantErr, gotErr)
+			requireStatus(t, tc.wantErr, gotErr)
 		})
 	}
 }
 
 func TestPriceServerList(t *testing.T) {
 	now := time.Now().UTC()
+	minPrice := models.MustParsePrice("10.5")
+	maxChanges := int64(3)
+	total := int64(2)
 
 	testCases := []struct {
 		name string
@@ -133,12 +172,19 @@ func TestPriceServerList(t *testing.T) {
 		limit     int
 		orderBy   string
 		orderType int32
+		pageToken string
+		filter    models.PriceFilter
+		skipTotal bool
+		currency  string
+		bestPrice bool
 
-		mockPriceRepoPrices []models.Price
-		mockPriceRepoErr    error
+		mockPriceRepoPage models.PricePage
+		mockPriceRepoErr  error
 
-		wantResults []*pb.ListReply_Price
-		wantErr     error
+		wantResults       []*pb.ListReply_Price
+		wantNextPageToken string
+		wantTotal         *int64
+		wantErr           error
 	}{
 		{
 			name: "Repo returns error",
@@ -148,11 +194,11 @@ func TestPriceServerList(t *testing.T) {
 			orderBy:   "name",
 			orderType: 1,
 
-			mockPriceRepoPrices: []models.Price{},
-			mockPriceRepoErr:    errors.New(`some error...`),
+			mockPriceRepoPage: models.PricePage{},
+			mockPriceRepoErr:  errors.New(`some error...`),
 
 			wantResults: nil,
-			wantErr:     errors.New(`some error...`),
+			wantErr:     statusError(errors.New(`some error...`)),
 		},
 		{
 			name: "Repo returns empty result",
@@ -162,8 +208,8 @@ func TestPriceServerList(t *testing.T) {
 			orderBy:   "name",
 			orderType: 1,
 
-			mockPriceRepoPrices: []models.Price{},
-			mockPriceRepoErr:    nil,
+			mockPriceRepoPage: models.PricePage{},
+			mockPriceRepoErr:  nil,
 
 			wantResults: nil,
 			wantErr:     nil,
@@ -176,15 +222,101 @@ func TestPriceServerList(t *testing.T) {
 			orderBy:   "name",
 			orderType: 1,
 
-			mockPriceRepoPrices: []models.Price{
-				{Name: "Product 1", Price: 100.99, Changes: 11, UpdatedAt: now},
-				{Name: "Product 2", Price: 0, Changes: 1, UpdatedAt: now},
+			mockPriceRepoPage: models.PricePage{
+				Prices: []models.Price{
+					{Name: "Product 1", Price: models.MustParsePrice("100.99"), Changes: 11, UpdatedAt: now},
+					{Name: "Product 2", Price: models.MustParsePrice("0"), Changes: 1, UpdatedAt: now},
+				},
+				Total: &total,
+			},
+			mockPriceRepoErr: nil,
+
+			wantResults: []*pb.ListReply_Price{
+				{Name: "Product 1", Price: "100.99", Changes: 11, UpdatedAt: timestamppb.New(now)},
+				{Name: "Product 2", Price: "0", Changes: 1, UpdatedAt: timestamppb.New(now)},
+			},
+			wantTotal: &total,
+			wantErr:   nil,
+		},
+		{
+			name: "Repo returns results with next page token",
+
+			limit:     1,
+			orderBy:   "price",
+			orderType: -1,
+			pageToken: "token1",
+			skipTotal: true,
+
+			mockPriceRepoPage: models.PricePage{
+				Prices: []models.Price{
+					{Name: "Product 1", Price: models.MustParsePrice("100.99"), Changes: 11, UpdatedAt: now},
+				},
+				NextPageToken: "token2",
+			},
+			mockPriceRepoErr: nil,
+
+			wantResults: []*pb.ListReply_Price{
+				{Name: "Product 1", Price: "100.99", Changes: 11, UpdatedAt: timestamppb.New(now)},
+			},
+			wantNextPageToken: "token2",
+			wantErr:           nil,
+		},
+		{
+			name: "Repo returns results in currency",
+
+			currency: "EUR",
+
+			mockPriceRepoPage: models.PricePage{
+				Prices: []models.Price{
+					{Name: "Product 1", Price: models.MustParsePrice("85.84"), Currency: "EUR", Changes: 1, UpdatedAt: now},
+				},
+			},
+			mockPriceRepoErr: nil,
+
+			wantResults: []*pb.ListReply_Price{
+				{Name: "Product 1", Price: "85.84", Currency: "EUR", Changes: 1, UpdatedAt: timestamppb.New(now)},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Repo returns best prices",
+
+			bestPrice: true,
+
+			mockPriceRepoPage: models.PricePage{
+				Prices: []models.Price{
+					{Source: "shop2", Name: "Product 1", Price: models.MustParsePrice("8"), Changes: 1, UpdatedAt: now},
+				},
+			},
+			mockPriceRepoErr: nil,
+
+			wantResults: []*pb.ListReply_Price{
+				{Source: "shop2", Name: "Product 1", Price: "8", Changes: 1, UpdatedAt: timestamppb.New(now)},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Repo returns filtered results",
+
+			filter: models.PriceFilter{
+				Source:       "shop1",
+				NamePrefix:   "Product",
+				NameContains: "1",
+				MinPrice:     &minPrice,
+				MaxChanges:   &maxChanges,
+				UpdatedFrom:  now.Add(-time.Hour),
+				UpdatedTo:    now,
+			},
+
+			mockPriceRepoPage: models.PricePage{
+				Prices: []models.Price{
+					{Name: "Product 1", Price: models.MustParsePrice("100.99"), Changes: 1, UpdatedAt: now},
+				},
 			},
 			mockPriceRepoErr: nil,
 
 			wantResults: []*pb.ListReply_Price{
-				{Name: "Product 1", Price: 100.99, Changes: 11, UpdatedAt: timestamppb.New(now)},
-				{Name: "Product 2", Price: 0, Changes: 1, UpdatedAt: timestamppb.New(now)},
+				{Name: "Product 1", Price: "100.99", Changes: 1, UpdatedAt: timestamppb.New(now)},
 			},
 			wantErr: nil,
 		},
@@ -203,18 +335,872 @@ func TestPriceServerList(t *testing.T) {
 			mockPriceRepo := mocks.NewMockPriceRepo(ctrl)
 			mockPriceRepo.
 				EXPECT().
-				List(tc.skip, tc.limit, tc.orderBy, tc.orderType).
-				Return(tc.mockPriceRepoPrices, tc.mockPriceRepoErr)
+				List(gomock.Any(), models.PriceQuery{
+					Skip:      tc.skip,
+					Limit:     tc.limit,
+					OrderBy:   tc.orderBy,
+					OrderType: tc.orderType,
+					PageToken: tc.pageToken,
+					Filter:    tc.filter,
+					WithTotal: !tc.skipTotal,
+					Currency:  tc.currency,
+					BestPrice: tc.bestPrice,
+				}).
+				Return(tc.mockPriceRepoPage, tc.mockPriceRepoErr)
 
-			priceServer := NewPriceServer(mockLogger, nil, mockPriceRepo)
-			request := &pb.ListRequest{Skip: int64(tc.skip), Limit: int64(tc.limit), OrderBy: tc.orderBy, OrderType: int32(tc.orderType)}
+			priceServer := NewPriceServer(mockLogger, nil, mockPriceRepo, nil, nil, nil)
+			request := &pb.ListRequest{
+				Skip:         int64(tc.skip),
+				Limit:        int64(tc.limit),
+				OrderBy:      tc.orderBy,
+				OrderType:    int32(tc.orderType),
+				PageToken:    tc.pageToken,
+				NamePrefix:   tc.filter.NamePrefix,
+				NameContains: tc.filter.NameContains,
+				MinPrice:     priceString(tc.filter.MinPrice),
+				MaxPrice:     priceString(tc.filter.MaxPrice),
+				MinChanges:   tc.filter.MinChanges,
+				MaxChanges:   tc.filter.MaxChanges,
+				SkipTotal:    tc.skipTotal,
+				Currency:     strings.ToLower(tc.currency),
+				BestPrice:    tc.bestPrice,
+				Source:       tc.filter.Source,
+			}
+			if !tc.filter.UpdatedFrom.IsZero() {
+				request.UpdatedFrom = timestamppb.New(tc.filter.UpdatedFrom)
+			}
+			if !tc.filter.UpdatedTo.IsZero() {
+				request.UpdatedTo = timestamppb.New(tc.filter.UpdatedTo)
+			}
 
 			gotReply, gotErr := priceServer.List(context.Background(), request)
 
 			if len(tc.wantResults) > 0 {
 				require.Equal(t, tc.wantResults, gotReply.Results)
+				require.Equal(t, tc.wantNextPageToken, gotReply.NextPageToken)
+				require.Equal(t, tc.wantTotal, gotReply.Total)
+			}
+			requireStatus(t, tc.wantErr, gotErr)
+		})
+	}
+}
+
+func TestPriceServerListInvalidPrice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := mocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+
+	priceServer := NewPriceServer(mockLogger, nil, nil, nil, nil, nil)
+	maxPrice := "10,5"
+
+	gotReply, gotErr := priceServer.List(context.Background(), &pb.ListRequest{MaxPrice: &maxPrice})
+
+	require.Nil(t, gotReply)
+	requireStatus(t, invalidArgument("max_price", errors.New(`invalid max_price "10,5"`)), gotErr)
+
+	gotReply, gotErr = priceServer.List(context.Background(), &pb.ListRequest{Currency: "euro"})
+
+	require.Nil(t, gotReply)
+	requireStatus(t, invalidArgument("currency", errors.New(`invalid currency "euro"`)), gotErr)
+}
+
+func priceString(price *primitive.Decimal128) *string {
+	if price == nil {
+		return nil
+	}
+	s := price.String()
+	return &s
+}
+
+func TestPriceServerGetPrice(t *testing.T) {
+	now := time.Now().UTC()
+
+	testCases := []struct {
+		name string
+
+		productName     string
+		source          string
+		isMockPriceRepo bool
+
+		mockPriceRepoPrice *models.Price
+		mockPriceRepoErr   error
+
+		wantReply *pb.GetPriceReply
+		wantErr   error
+	}{
+		{
+			name: "Empty name",
+
+			productName: "",
+
+			wantReply: nil,
+			wantErr:   invalidArgument("name", errors.New("name is required")),
+		},
+		{
+			name: "Repo returns error",
+
+			productName:     "Product 1",
+			isMockPriceRepo: true,
+
+			mockPriceRepoErr: errors.New(`some error...`),
+
+			wantReply: nil,
+			wantErr:   statusError(errors.New(`some error...`)),
+		},
+		{
+			name: "Price not found",
+
+			productName:     "Product 1",
+			isMockPriceRepo: true,
+
+			mockPriceRepoPrice: nil,
+
+			wantReply: nil,
+			wantErr:   notFound(`price "Product 1" not found`),
+		},
+		{
+			name: "Repo returns price",
+
+			productName:     "Product 1",
+			source:          "shop1",
+			isMockPriceRepo: true,
+
+			mockPriceRepoPrice: &models.Price{Source: "shop1", Name: "Product 1", Price: models.MustParsePrice("100.99"), Changes: 1, UpdatedAt: now},
+
+			wantReply: &pb.GetPriceReply{
+				Price: &pb.ListReply_Price{Source: "shop1", Name: "Product 1", Price: "100.99", Changes: 1, UpdatedAt: timestamppb.New(now)},
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+			mockPriceRepo := mocks.NewMockPriceRepo(ctrl)
+			if tc.isMockPriceRepo {
+				mockPriceRepo.
+					EXPECT().
+					Get(gomock.Any(), tc.source, tc.productName).
+					Return(tc.mockPriceRepoPrice, tc.mockPriceRepoErr)
+			}
+
+			priceServer := NewPriceServer(mockLogger, nil, mockPriceRepo, nil, nil, nil)
+
+			gotReply, gotErr := priceServer.GetPrice(context.Background(), &pb.GetPriceRequest{Name: tc.productName, Source: tc.source})
+
+			require.Equal(t, tc.wantReply, gotReply)
+			requireStatus(t, tc.wantErr, gotErr)
+		})
+	}
+}
+
+func TestPriceServerGetPrices(t *testing.T) {
+	now := time.Now().UTC()
+
+	testCases := []struct {
+		name string
+
+		names           []string
+		isMockPriceRepo bool
+
+		mockPriceRepoPrices []models.Price
+		mockPriceRepoErr    error
+
+		wantReply *pb.GetPricesReply
+		wantErr   error
+	}{
+		{
+			name: "Too many names",
+
+			names: make([]string, MaxNames+1),
+
+			wantReply: nil,
+			wantErr:   invalidArgument("names", fmt.Errorf("too many names, max %d", MaxNames)),
+		},
+		{
+			name: "Repo returns error",
+
+			names:           []string{"Product 1"},
+			isMockPriceRepo: true,
+
+			mockPriceRepoErr: errors.New(`some error...`),
+
+			wantReply: nil,
+			wantErr:   statusError(errors.New(`some error...`)),
+		},
+		{
+			name: "Repo returns prices in order of names",
+
+			names:           []string{"Product 2", "Product 3", "Product 1"},
+			isMockPriceRepo: true,
+
+			mockPriceRepoPrices: []models.Price{
+				{Name: "Product 1", Price: models.MustParsePrice("100.99"), Changes: 1, UpdatedAt: now},
+				{Name: "Product 2", Price: models.MustParsePrice("0"), Changes: 0, UpdatedAt: now},
+			},
+
+			wantReply: &pb.GetPricesReply{
+				Results: []*pb.ListReply_Price{
+					{Name: "Product 2", Price: "0", Changes: 0, UpdatedAt: timestamppb.New(now)},
+					{Name: "Product 1", Price: "100.99", Changes: 1, UpdatedAt: timestamppb.New(now)},
+				},
+				NotFound: []string{"Product 3"},
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+			mockPriceRepo := mocks.NewMockPriceRepo(ctrl)
+			if tc.isMockPriceRepo {
+				mockPriceRepo.
+					EXPECT().
+					GetMany(gomock.Any(), "", tc.names).
+					Return(tc.mockPriceRepoPrices, tc.mockPriceRepoErr)
 			}
-			require.Equal(t, tc.wantErr, gotErr)
+
+			priceServer := NewPriceServer(mockLogger, nil, mockPriceRepo, nil, nil, nil)
+
+			gotReply, gotErr := priceServer.GetPrices(context.Background(), &pb.GetPricesRequest{Names: tc.names})
+
+			require.Equal(t, tc.wantReply, gotReply)
+			requireStatus(t, tc.wantErr, gotErr)
+		})
+	}
+}
+
+func TestPriceServerGetHistory(t *testing.T) {
+	now := time.Now().UTC()
+	from := now.Add(-time.Hour)
+
+	testCases := []struct {
+		name string
+
+		request *pb.GetHistoryRequest
+
+		wantFrom time.Time
+		wantTo   time.Time
+
+		mockPriceRepoHistory []models.PriceHistory
+		mockPriceRepoErr     error
+
+		wantResults []*pb.GetHistoryReply_Price
+		wantErr     error
+	}{
+		{
+			name: "Repo returns error",
+
+			request: &pb.GetHistoryRequest{Name: "Product 1", Skip: 1, Limit: 100},
+
+			mockPriceRepoHistory: []models.PriceHistory{},
+			mockPriceRepoErr:     errors.New(`some error...`),
+
+			wantResults: nil,
+			wantErr:     statusError(errors.New(`some error...`)),
+		},
+		{
+			name: "Repo returns results without range",
+
+			request: &pb.GetHistoryRequest{Name: "Product 1", Source: "shop1", Skip: 1, Limit: 100},
+
+			mockPriceRepoHistory: []models.PriceHistory{
+				{Name: "Product 1", Price: models.MustParsePrice("100.99"), CreatedAt: from},
+				{Name: "Product 1", Price: models.MustParsePrice("0"), CreatedAt: now},
+			},
+			mockPriceRepoErr: nil,
+
+			wantResults: []*pb.GetHistoryReply_Price{
+				{Price: "100.99", CreatedAt: timestamppb.New(from)},
+				{Price: "0", CreatedAt: timestamppb.New(now)},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Repo returns results with range",
+
+			request: &pb.GetHistoryRequest{
+				Name:  "Product 1",
+				From:  timestamppb.New(from),
+				To:    timestamppb.New(now),
+				Skip:  0,
+				Limit: 10,
+			},
+
+			wantFrom: from,
+			wantTo:   now,
+
+			mockPriceRepoHistory: []models.PriceHistory{
+				{Name: "Product 1", Price: models.MustParsePrice("100.99"), CreatedAt: from},
+			},
+			mockPriceRepoErr: nil,
+
+			wantResults: []*pb.GetHistoryReply_Price{
+				{Price: "100.99", CreatedAt: timestamppb.New(from)},
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+			mockPriceRepo := mocks.NewMockPriceRepo(ctrl)
+			mockPriceRepo.
+				EXPECT().
+				History(gomock.Any(), tc.request.Source, tc.request.Name, tc.wantFrom, tc.wantTo, int(tc.request.Skip), int(tc.request.Limit)).
+				Return(tc.mockPriceRepoHistory, tc.mockPriceRepoErr)
+
+			priceServer := NewPriceServer(mockLogger, nil, mockPriceRepo, nil, nil, nil)
+
+			gotReply, gotErr := priceServer.GetHistory(context.Background(), tc.request)
+
+			if len(tc.wantResults) > 0 {
+				require.Equal(t, tc.wantResults, gotReply.Results)
+			}
+			requireStatus(t, tc.wantErr, gotErr)
+		})
+	}
+}
+
+func TestPriceServerGetJob(t *testing.T) {
+	now := time.Now().UTC()
+	id := primitive.NewObjectID()
+
+	testCases := []struct {
+		name string
+
+		id string
+
+		mockJobRepoJob *models.Job
+		mockJobRepoErr error
+
+		wantReply *pb.GetJobReply
+		wantErr   error
+	}{
+		{
+			name: "Repo returns error",
+
+			id: "invalid",
+
+			mockJobRepoErr: errors.New(`some error...`),
+
+			wantReply: nil,
+			wantErr:   statusError(errors.New(`some error...`)),
+		},
+		{
+			name: "Job not found",
+
+			id: id.Hex(),
+
+			mockJobRepoJob: nil,
+
+			wantReply: nil,
+			wantErr:   notFound("job %s not found", id.Hex()),
+		},
+		{
+			name: "Repo returns job",
+
+			id: id.Hex(),
+
+			mockJobRepoJob: &models.Job{ID: id, Feed: models.Feed{URL: "http://yandex.ru"}, State: models.JobRunning, Processed: 10, CreatedAt: now, StartedAt: now},
+
+			wantReply: &pb.GetJobReply{
+				Job: &pb.Job{
+					Id:        id.Hex(),
+					Url:       "http://yandex.ru",
+					State:     pb.Job_RUNNING,
+					Processed: 10,
+					Stats:     &pb.ImportStats{Rejects: []*pb.ImportStats_Reject{}},
+					CreatedAt: timestamppb.New(now),
+					StartedAt: timestamppb.New(now),
+				},
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+			mockJobRepo := mocks.NewMockJobRepo(ctrl)
+			mockJobRepo.
+				EXPECT().
+				Get(gomock.Any(), tc.id).
+				Return(tc.mockJobRepoJob, tc.mockJobRepoErr)
+
+			priceServer := NewPriceServer(mockLogger, nil, nil, mockJobRepo, nil, nil)
+
+			gotReply, gotErr := priceServer.GetJob(context.Background(), &pb.GetJobRequest{Id: tc.id})
+
+			require.Equal(t, tc.wantReply, gotReply)
+			requireStatus(t, tc.wantErr, gotErr)
+		})
+	}
+}
+
+func TestPriceServerListJobs(t *testing.T) {
+	now := time.Now().UTC()
+	id1 := primitive.NewObjectID()
+	id2 := primitive.NewObjectID()
+
+	testCases := []struct {
+		name string
+
+		skip  int
+		limit int
+
+		mockJobRepoJobs []models.Job
+		mockJobRepoErr  error
+
+		wantResults []*pb.Job
+		wantErr     error
+	}{
+		{
+			name: "Repo returns error",
+
+			skip:  1,
+			limit: 100,
+
+			mockJobRepoJobs: []models.Job{},
+			mockJobRepoErr:  errors.New(`some error...`),
+
+			wantResults: nil,
+			wantErr:     statusError(errors.New(`some error...`)),
+		},
+		{
+			name: "Repo returns results",
+
+			skip:  0,
+			limit: 10,
+
+			mockJobRepoJobs: []models.Job{
+				{ID: id2, Feed: models.Feed{URL: "http://yandex.ru/2"}, State: models.JobQueued, CreatedAt: now},
+				{ID: id1, Feed: models.Feed{URL: "http://yandex.ru/1"}, State: models.JobDone, Processed: 1, Stats: models.ImportStats{Parsed: 1, Inserted: 1}, CreatedAt: now, StartedAt: now, FinishedAt: now},
+			},
+			mockJobRepoErr: nil,
+
+			wantResults: []*pb.Job{
+				{Id: id2.Hex(), Url: "http://yandex.ru/2", State: pb.Job_QUEUED, Stats: &pb.ImportStats{Rejects: []*pb.ImportStats_Reject{}}, CreatedAt: timestamppb.New(now)},
+				{Id: id1.Hex(), Url: "http://yandex.ru/1", State: pb.Job_DONE, Processed: 1, Stats: &pb.ImportStats{Parsed: 1, Inserted: 1, Rejects: []*pb.ImportStats_Reject{}}, CreatedAt: timestamppb.New(now), StartedAt: timestamppb.New(now), FinishedAt: timestamppb.New(now)},
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+			mockJobRepo := mocks.NewMockJobRepo(ctrl)
+			mockJobRepo.
+				EXPECT().
+				List(gomock.Any(), tc.skip, tc.limit).
+				Return(tc.mockJobRepoJobs, tc.mockJobRepoErr)
+
+			priceServer := NewPriceServer(mockLogger, nil, nil, mockJobRepo, nil, nil)
+			request := &pb.ListJobsRequest{Skip: int64(tc.skip), Limit: int64(tc.limit)}
+
+			gotReply, gotErr := priceServer.ListJobs(context.Background(), request)
+
+			if len(tc.wantResults) > 0 {
+				require.Equal(t, tc.wantResults, gotReply.Results)
+			}
+			requireStatus(t, tc.wantErr, gotErr)
+		})
+	}
+}
+
+func TestPriceServerSetRates(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		base  string
+		rates map[string]string
+
+		wantMockRateRepoRates []models.Rate
+		mockRateRepoErr       error
+
+		wantErr error
+	}{
+		{
+			name: "Empty base",
+
+			rates: map[string]string{"EUR": "0.85"},
+
+			wantErr: invalidArgument("base", errors.New("base is required")),
+		},
+		{
+			name: "Invalid currency",
+
+			base:  "USD",
+			rates: map[string]string{"euro": "0.85"},
+
+			wantErr: invalidArgument("rates", errors.New(`invalid currency "euro"`)),
+		},
+		{
+			name: "Invalid rate",
+
+			base:  "USD",
+			rates: map[string]string{"EUR": "0"},
+
+			wantErr: invalidArgument("rates", errors.New(`invalid rate "0"`)),
+		},
+		{
+			name: "Base with another rate",
+
+			base:  "USD",
+			rates: map[string]string{"usd": "2"},
+
+			wantErr: invalidArgument("rates", errors.New("rate of base USD must be 1")),
+		},
+		{
+			name: "Repo returns error",
+
+			base:  "USD",
+			rates: map[string]string{},
+
+			wantMockRateRepoRates: []models.Rate{
+				{Currency: "USD", Rate: models.MustParsePrice("1")},
+			},
+			mockRateRepoErr: errors.New("some error..."),
+
+			wantErr: statusError(errors.New("some error...")),
+		},
+		{
+			name: "Rates are set",
+
+			base:  "usd",
+			rates: map[string]string{"RUB": "73.5", "eur": "0.85", "USD": "1.00"},
+
+			wantMockRateRepoRates: []models.Rate{
+				{Currency: "EUR", Rate: models.MustParsePrice("0.85")},
+				{Currency: "RUB", Rate: models.MustParsePrice("73.5")},
+				{Currency: "USD", Rate: models.MustParsePrice("1")},
+			},
+			mockRateRepoErr: nil,
+
+			wantErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+			mockRateRepo := mocks.NewMockRateRepo(ctrl)
+			if tc.wantMockRateRepoRates != nil {
+				mockRateRepo.
+					EXPECT().
+					Set(gomock.Any(), gomock.Any(), tc.wantMockRateRepoRates).
+					Return(tc.mockRateRepoErr)
+			}
+
+			priceServer := NewPriceServer(mockLogger, nil, nil, nil, mockRateRepo, nil)
+
+			gotReply, gotErr := priceServer.SetRates(context.Background(), &pb.SetRatesRequest{Base: tc.base, Rates: tc.rates})
+
+			if tc.wantErr != nil {
+				require.Nil(t, gotReply)
+			} else {
+				require.Equal(t, &pb.SetRatesReply{}, gotReply)
+			}
+			requireStatus(t, tc.wantErr, gotErr)
+		})
+	}
+}
+
+func TestPriceServerCreateSchedule(t *testing.T) {
+	id := primitive.NewObjectID()
+
+	testCases := []struct {
+		name string
+
+		request *pb.CreateScheduleRequest
+
+		isMockImporter  bool
+		mockImporterErr error
+
+		wantSchedule        models.Schedule
+		isMockScheduleRepo  bool
+		mockScheduleRepoErr error
+
+		wantErr error
+	}{
+		{
+			name: "Empty feed",
+
+			request: &pb.CreateScheduleRequest{Cron: "0 * * * *"},
+
+			wantErr: invalidArgument("feed", errors.New("feed is required")),
+		},
+		{
+			name: "Invalid schedule",
+
+			request: &pb.CreateScheduleRequest{Feed: &pb.FetchRequest{Url: "http://yandex.ru"}},
+
+			wantErr: invalidArgument("cron", errors.New("cron or interval is required")),
+		},
+		{
+			name: "Invalid feed",
+
+			request: &pb.CreateScheduleRequest{Feed: &pb.FetchRequest{Url: ""}, Cron: "0 * * * *"},
+
+			isMockImporter:  true,
+			mockImporterErr: &models.FieldError{Field: "url", Err: errors.New(`parse "": empty url`)},
+
+			wantErr: invalidArgument("feed.url", errors.New(`parse "": empty url`)),
+		},
+		{
+			name: "Repo returns error",
+
+			request: &pb.CreateScheduleRequest{Feed: &pb.FetchRequest{Url: "http://yandex.ru"}, Cron: "0 * * * *"},
+
+			isMockImporter: true,
+
+			wantSchedule:        models.Schedule{Feed: models.Feed{URL: "http://yandex.ru"}, Cron: "0 * * * *"},
+			isMockScheduleRepo:  true,
+			mockScheduleRepoErr: errors.New("some error..."),
+
+			wantErr: statusError(errors.New("some error...")),
+		},
+		{
+			name: "Schedule created",
+
+			request: &pb.CreateScheduleRequest{Feed: &pb.FetchRequest{Url: "http://yandex.ru"}, Interval: durationpb.New(time.Hour)},
+
+			isMockImporter: true,
+
+			wantSchedule:       models.Schedule{Feed: models.Feed{URL: "http://yandex.ru"}, Interval: time.Hour},
+			isMockScheduleRepo: true,
+
+			wantErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+			mockImporter := mocks.NewMockImporter(ctrl)
+			if tc.isMockImporter {
+				mockImporter.
+					EXPECT().
+					Validate(models.FeedFromPB(tc.request.Feed)).
+					Return(tc.mockImporterErr)
+			}
+			mockScheduleRepo := mocks.NewMockScheduleRepo(ctrl)
+			if tc.isMockScheduleRepo {
+				mockScheduleRepo.
+					EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, schedule models.Schedule) (models.Schedule, error) {
+						require.False(t, schedule.CreatedAt.IsZero())
+						require.Equal(t, schedule.Next(schedule.CreatedAt), schedule.NextRunAt)
+						got := schedule
+						got.CreatedAt, got.NextRunAt = time.Time{}, time.Time{}
+						require.Equal(t, tc.wantSchedule, got)
+						schedule.ID = id
+						return schedule, tc.mockScheduleRepoErr
+					})
+			}
+
+			priceServer := NewPriceServer(mockLogger, mockImporter, nil, nil, nil, mockScheduleRepo)
+
+			gotReply, gotErr := priceServer.CreateSchedule(context.Background(), tc.request)
+
+			if tc.wantErr != nil {
+				require.Nil(t, gotReply)
+			} else {
+				require.Equal(t, id.Hex(), gotReply.Schedule.Id)
+				require.Equal(t, tc.request.Feed, gotReply.Schedule.Feed)
+				require.Equal(t, tc.request.Interval, gotReply.Schedule.Interval)
+				require.NotNil(t, gotReply.Schedule.NextRunAt)
+			}
+			requireStatus(t, tc.wantErr, gotErr)
+		})
+	}
+}
+
+func TestPriceServerListSchedules(t *testing.T) {
+	now := time.Now().UTC()
+	id := primitive.NewObjectID()
+
+	testCases := []struct {
+		name string
+
+		skip  int
+		limit int
+
+		mockScheduleRepoSchedules []models.Schedule
+		mockScheduleRepoErr       error
+
+		wantReply *pb.ListSchedulesReply
+		wantErr   error
+	}{
+		{
+			name: "Repo returns error",
+
+			limit: 10,
+
+			mockScheduleRepoErr: errors.New("some error..."),
+
+			wantReply: nil,
+			wantErr:   statusError(errors.New("some error...")),
+		},
+		{
+			name: "Repo returns results",
+
+			skip:  1,
+			limit: 10,
+
+			mockScheduleRepoSchedules: []models.Schedule{
+				{ID: id, Feed: models.Feed{URL: "http://yandex.ru"}, Cron: "0 * * * *", NextRunAt: now, CreatedAt: now},
+			},
+
+			wantReply: &pb.ListSchedulesReply{Results: []*pb.Schedule{
+				{Id: id.Hex(), Feed: &pb.FetchRequest{Url: "http://yandex.ru"}, Cron: "0 * * * *", NextRunAt: timestamppb.New(now), CreatedAt: timestamppb.New(now)},
+			}},
+			wantErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+			mockScheduleRepo := mocks.NewMockScheduleRepo(ctrl)
+			mockScheduleRepo.
+				EXPECT().
+				List(gomock.Any(), tc.skip, tc.limit).
+				Return(tc.mockScheduleRepoSchedules, tc.mockScheduleRepoErr)
+
+			priceServer := NewPriceServer(mockLogger, nil, nil, nil, nil, mockScheduleRepo)
+
+			gotReply, gotErr := priceServer.ListSchedules(context.Background(), &pb.ListSchedulesRequest{Skip: int64(tc.skip), Limit: int64(tc.limit)})
+
+			require.Equal(t, tc.wantReply, gotReply)
+			requireStatus(t, tc.wantErr, gotErr)
+		})
+	}
+}
+
+func TestPriceServerDeleteSchedule(t *testing.T) {
+	id := primitive.NewObjectID().Hex()
+
+	testCases := []struct {
+		name string
+
+		mockScheduleRepoDeleted bool
+		mockScheduleRepoErr     error
+
+		wantReply *pb.DeleteScheduleReply
+		wantErr   error
+	}{
+		{
+			name: "Repo returns error",
+
+			mockScheduleRepoErr: errors.New("some error..."),
+
+			wantReply: nil,
+			wantErr:   statusError(errors.New("some error...")),
+		},
+		{
+			name: "Schedule not found",
+
+			mockScheduleRepoDeleted: false,
+
+			wantReply: nil,
+			wantErr:   notFound("schedule %s not found", id),
+		},
+		{
+			name: "Schedule deleted",
+
+			mockScheduleRepoDeleted: true,
+
+			wantReply: &pb.DeleteScheduleReply{},
+			wantErr:   nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLogger := mocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+			mockScheduleRepo := mocks.NewMockScheduleRepo(ctrl)
+			mockScheduleRepo.
+				EXPECT().
+				Delete(gomock.Any(), id).
+				Return(tc.mockScheduleRepoDeleted, tc.mockScheduleRepoErr)
+
+			priceServer := NewPriceServer(mockLogger, nil, nil, nil, nil, mockScheduleRepo)
+
+			gotReply, gotErr := priceServer.DeleteSchedule(context.Background(), &pb.DeleteScheduleRequest{Id: id})
+
+			require.Equal(t, tc.wantReply, gotReply)
+			requireStatus(t, tc.wantErr, gotErr)
 		})
 	}
 }